@@ -44,6 +44,11 @@ type ARPEntry struct {
 	IPAddr net.IP
 	// MAC address
 	HWAddr net.HardwareAddr
+	// Hardware type, one of the ARPHRD_* values from
+	// include/uapi/linux/if_arp.h, e.g. 1 for Ethernet.
+	HWType uint16
+	// Netmask of proxy entries, "*" for regular entries
+	Mask string
 	// Name of the device
 	Device string
 	// Flags
@@ -92,7 +97,7 @@ func parseARPEntries(data []byte) ([]ARPEntry, error) {
 }
 
 func parseARPEntry(columns []string) (ARPEntry, error) {
-	entry := ARPEntry{Device: columns[5]}
+	entry := ARPEntry{Mask: columns[4], Device: columns[5]}
 	ip := net.ParseIP(columns[0])
 	entry.IPAddr = ip
 
@@ -102,6 +107,12 @@ func parseARPEntry(columns []string) (ARPEntry, error) {
 		return ARPEntry{}, err
 	}
 
+	if hwType, err := strconv.ParseUint(columns[1], 0, 16); err == nil {
+		entry.HWType = uint16(hwType)
+	} else {
+		return ARPEntry{}, err
+	}
+
 	if flags, err := strconv.ParseUint(columns[2], 0, 8); err == nil {
 		entry.Flags = byte(flags)
 	} else {
@@ -115,3 +126,9 @@ func parseARPEntry(columns []string) (ARPEntry, error) {
 func (entry *ARPEntry) IsComplete() bool {
 	return entry.Flags&ATFComplete != 0
 }
+
+// IsPermanent returns true if ARP entry is marked with permanent flag, as
+// set for static entries.
+func (entry *ARPEntry) IsPermanent() bool {
+	return entry.Flags&ATFPermanent != 0
+}
@@ -37,6 +37,14 @@ func TestARP(t *testing.T) {
 		t.Errorf("want %s, got %s", want, got)
 	}
 
+	if want, got := uint16(1), arpFile[0].HWType; want != got {
+		t.Errorf("want HW type %d, got %d", want, got)
+	}
+
+	if want, got := "*", arpFile[0].Mask; want != got {
+		t.Errorf("want mask %s, got %s", want, got)
+	}
+
 	if want, got := "ens33", arpFile[0].Device; want != got {
 		t.Errorf("want ens33, got %s", got)
 	}
@@ -64,4 +72,22 @@ func TestARP(t *testing.T) {
 	if want, got := false, arpFile[1].IsComplete(); want != got {
 		t.Errorf("want %t, got %t", want, got)
 	}
+
+	if want, got := false, arpFile[1].IsPermanent(); want != got {
+		t.Errorf("want %t, got %t", want, got)
+	}
+}
+
+func TestParseARPEntriesPermanent(t *testing.T) {
+	entries, err := parseARPEntries([]byte("10.0.0.1 0x1 0x6 52:54:00:12:34:56 * br0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !entries[0].IsComplete() || !entries[0].IsPermanent() {
+		t.Errorf("expected one complete permanent entry, got %+v", entries)
+	}
+
+	if _, err := parseARPEntries([]byte("10.0.0.1 ether 0x2 52:54:00:12:34:56 * br0\n")); err == nil {
+		t.Error("expected error for malformed HW type")
+	}
 }
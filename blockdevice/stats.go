@@ -201,6 +201,30 @@ type DeviceMapperInfo struct {
 	UUID string
 }
 
+// HolderDeviceInfo models the list of devices that are built on top of this device.
+type HolderDeviceInfo struct {
+	// DeviceNames is the list of devices names
+	DeviceNames []string
+}
+
+// Partition contains info from files in /sys/block/<device>/<partition> for a
+// single partition of a block device.
+// https://www.kernel.org/doc/Documentation/ABI/stable/sysfs-block
+type Partition struct {
+	// Name is the partition's device name, e.g. "sda1".
+	Name string
+	// Number is the partition number from the partition file.
+	Number uint64
+	// Start is the offset of the partition from the start of the device in bytes.
+	Start uint64
+	// Size is the size of the partition in bytes.
+	Size uint64
+	// ReadOnly indicates if the partition is read-only.
+	ReadOnly bool
+	// Stats are the I/O counters of the partition from its stat file.
+	Stats IOStats
+}
+
 // UnderlyingDevices models the list of devices that this device is built from.
 type UnderlyingDeviceInfo struct {
 	// DeviceNames is the list of devices names
@@ -215,8 +239,10 @@ const (
 	sysBlockQueue       = "queue"
 	sysBlockDM          = "dm"
 	sysUnderlyingDev    = "slaves"
+	sysHolderDev        = "holders"
 	sysBlockSize        = "size"
 	sysDevicePath       = "device"
+	sysPartition        = "partition"
 )
 
 // FS represents the pseudo-filesystems proc and sys, which provides an
@@ -482,6 +508,23 @@ func (fs FS) SysBlockDeviceUnderlyingDevices(device string) (UnderlyingDeviceInf
 
 }
 
+// SysBlockDeviceHolders returns the devices using the block device, read from
+// /sys/block/<device>/holders. Together with SysBlockDeviceUnderlyingDevices it
+// allows reconstructing device-mapper and md stacking, e.g. LVM on dm-crypt.
+func (fs FS) SysBlockDeviceHolders(device string) (HolderDeviceInfo, error) {
+	holderDir, err := os.Open(fs.sys.Path(sysBlockPath, device, sysHolderDev))
+	if err != nil {
+		return HolderDeviceInfo{}, err
+	}
+	defer holderDir.Close()
+
+	holders, err := holderDir.Readdirnames(0)
+	if err != nil {
+		return HolderDeviceInfo{}, err
+	}
+	return HolderDeviceInfo{DeviceNames: holders}, nil
+}
+
 // SysBlockDeviceSize returns the size of the block device from /sys/block/<device>/size
 // in bytes by multiplying the value by the Linux sector length of 512.
 func (fs FS) SysBlockDeviceSize(device string) (uint64, error) {
@@ -492,6 +535,60 @@ func (fs FS) SysBlockDeviceSize(device string) (uint64, error) {
 	return procfs.SectorSize * size, nil
 }
 
+// SysBlockDevicePartitions returns the partitions of the block device read from
+// /sys/block/<device>/<partition>. Sizes and offsets are converted to bytes.
+func (fs FS) SysBlockDevicePartitions(device string) ([]Partition, error) {
+	dirs, err := os.ReadDir(fs.sys.Path(sysBlockPath, device))
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := []Partition{}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		// Only partitions have a partition file holding their number.
+		number, err := util.ReadUintFromFile(fs.sys.Path(sysBlockPath, device, d.Name(), sysPartition))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		partition := Partition{Name: d.Name(), Number: number}
+		for file, p := range map[string]*uint64{
+			"start":      &partition.Start,
+			sysBlockSize: &partition.Size,
+		} {
+			val, err := util.ReadUintFromFile(fs.sys.Path(sysBlockPath, device, d.Name(), file))
+			if err != nil {
+				return nil, err
+			}
+			*p = procfs.SectorSize * val
+		}
+
+		ro, err := util.ReadUintFromFile(fs.sys.Path(sysBlockPath, device, d.Name(), "ro"))
+		if err != nil {
+			return nil, err
+		}
+		partition.ReadOnly = ro != 0
+
+		stat, err := os.ReadFile(fs.sys.Path(sysBlockPath, device, d.Name(), "stat"))
+		if err != nil {
+			return nil, err
+		}
+		partition.Stats, _, err = parseSysBlockDeviceStat(stat)
+		if err != nil {
+			return nil, err
+		}
+
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
 // SysBlockDeviceIO returns stats for the block device io counters
 // IO done count: /sys/block/<disk>/device/iodone_cnt
 // IO error count: /sys/block/<disk>/device/ioerr_cnt.
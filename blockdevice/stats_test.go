@@ -223,6 +223,24 @@ func TestSysBlockDeviceUnderlyingDevices(t *testing.T) {
 	}
 }
 
+func TestSysBlockDeviceHolders(t *testing.T) {
+	blockdevice, err := NewFS(procfsFixtures, sysfsFixtures)
+	if err != nil {
+		t.Fatalf("failed to access blockdevice fs: %v", err)
+	}
+
+	holders, err := blockdevice.SysBlockDeviceHolders("sda")
+	if err != nil {
+		t.Fatal(err)
+	}
+	holdersExpected := HolderDeviceInfo{
+		DeviceNames: []string{"dm-0"},
+	}
+	if diff := cmp.Diff(holdersExpected, holders); diff != "" {
+		t.Fatalf("unexpected holders (-want +got):\n%s", diff)
+	}
+}
+
 func TestSysBlockDeviceSize(t *testing.T) {
 	blockdevice, err := NewFS("testdata/fixtures/proc", "testdata/fixtures/sys")
 	if err != nil {
@@ -241,3 +259,68 @@ func TestSysBlockDeviceSize(t *testing.T) {
 		t.Errorf("Incorrect BlockDeviceSize, expected: \n%+v, got: \n%+v", size7Expected, size7)
 	}
 }
+
+func TestSysBlockDevicePartitions(t *testing.T) {
+	blockdevice, err := NewFS(procfsFixtures, sysfsFixtures)
+	if err != nil {
+		t.Fatalf("failed to access blockdevice fs: %v", err)
+	}
+
+	got, err := blockdevice.SysBlockDevicePartitions("sda")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Partition{
+		{
+			Name:   "sda1",
+			Number: 1,
+			Start:  1048576,
+			Size:   536870912,
+			Stats: IOStats{
+				ReadIOs:         312,
+				ReadSectors:     26066,
+				ReadTicks:       102,
+				WriteIOs:        2,
+				WriteSectors:    2,
+				WriteTicks:      1,
+				IOsTotalTicks:   148,
+				WeightedIOTicks: 103,
+			},
+		},
+		{
+			Name:     "sda2",
+			Number:   2,
+			Start:    537919488,
+			Size:     1919845490688,
+			ReadOnly: true,
+			Stats: IOStats{
+				ReadIOs:         9652590,
+				ReadMerges:      396792,
+				ReadSectors:     759277484,
+				ReadTicks:       412830,
+				WriteIOs:        8422547,
+				WriteMerges:     6731723,
+				WriteSectors:    286915321,
+				WriteTicks:      13947417,
+				IOsTotalTicks:   5658210,
+				WeightedIOTicks: 19174463,
+				DiscardIOs:      1,
+				DiscardMerges:   2,
+				DiscardSectors:  3,
+				DiscardTicks:    12,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected partitions (-want +got):\n%s", diff)
+	}
+
+	got, err = blockdevice.SysBlockDevicePartitions("dm-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no partitions for dm-0, got %v", got)
+	}
+}
@@ -75,3 +75,32 @@ type CommitStats struct {
 	MaxCommitMs   uint64
 	TotalCommitMs uint64
 }
+
+// UnallocatedBytes returns the raw device space not yet allocated to any
+// data, metadata or system chunk. Once it reaches zero, new chunks can no
+// longer be allocated and the filesystem may run into ENOSPC even while
+// the existing chunks still have free space. The boolean is false if the
+// size of any device is unknown.
+func (s *Stats) UnallocatedBytes() (uint64, bool) {
+	if len(s.Devices) == 0 {
+		return 0, false
+	}
+
+	var size, allocated uint64
+	for _, d := range s.Devices {
+		if d.Size == 0 {
+			return 0, false
+		}
+		size += d.Size
+	}
+	for _, a := range []*AllocationStats{s.Allocation.Data, s.Allocation.Metadata, s.Allocation.System} {
+		if a != nil {
+			allocated += a.DiskTotalBytes
+		}
+	}
+
+	if allocated > size {
+		return 0, true
+	}
+	return size - allocated, true
+}
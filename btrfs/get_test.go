@@ -126,3 +126,28 @@ func TestFSBtrfsStats(t *testing.T) {
 		}
 	}
 }
+
+func TestStatsUnallocatedBytes(t *testing.T) {
+	btrfs, err := NewFS("testdata/fixtures/sys")
+	if err != nil {
+		t.Fatalf("failed to access Btrfs filesystem: %v", err)
+	}
+	stats, err := btrfs.Stats()
+	if err != nil {
+		t.Fatalf("failed to parse Btrfs stats: %v", err)
+	}
+
+	// The device sizes of the second fixture are unknown.
+	for i, tt := range []struct {
+		want uint64
+		ok   bool
+	}{
+		{want: 17163091968, ok: true},
+		{want: 0, ok: false},
+	} {
+		got, ok := stats[i].UnallocatedBytes()
+		if tt.want != got || tt.ok != ok {
+			t.Errorf("fs %q unexpected unallocated bytes:\nwant: %d, %t\nhave: %d, %t", stats[i].UUID, tt.want, tt.ok, got, ok)
+		}
+	}
+}
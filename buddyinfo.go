@@ -72,7 +72,7 @@ func parseBuddyInfo(r io.Reader) ([]BuddyInfo, error) {
 		for i := range arraySize {
 			sizes[i], err = strconv.ParseFloat(parts[i+4], 64)
 			if err != nil {
-				return nil, fmt.Errorf("%w: Invalid valid in buddyinfo: %f: %w", ErrFileParse, sizes[i], err)
+				return nil, fmt.Errorf("%w: Invalid value in buddyinfo: %q: %w", ErrFileParse, parts[i+4], err)
 			}
 		}
 
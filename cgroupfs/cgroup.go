@@ -0,0 +1,309 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cgroupfs
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs"
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const psiLineFormat = "avg10=%f avg60=%f avg300=%f total=%d"
+
+// CPUStat contains the values of cpu.stat . The throttling and burst
+// counters are only reported when the cpu controller is enabled.
+type CPUStat struct {
+	UsageUsec     uint64
+	UserUsec      uint64
+	SystemUsec    uint64
+	NrPeriods     *uint64
+	NrThrottled   *uint64
+	ThrottledUsec *uint64
+	NrBursts      *uint64
+	BurstUsec     *uint64
+}
+
+// IOStat contains the values of a single device line of io.stat .
+type IOStat struct {
+	Rbytes uint64
+	Wbytes uint64
+	Rios   uint64
+	Wios   uint64
+	Dbytes uint64
+	Dios   uint64
+}
+
+// Cgroup contains the statistics of a single control group. Files of
+// controllers which are not enabled for the group are skipped.
+// https://docs.kernel.org/admin-guide/cgroup-v2.html
+type Cgroup struct {
+	// Path is the path of the group relative to the mount point, "/" for
+	// the root group.
+	Path          string
+	CPU           *CPUStat // cpu.stat
+	MemoryCurrent *uint64  // memory.current, in bytes
+	// MemoryMax is the memory limit in bytes, nil if unlimited. memory.max
+	MemoryMax *uint64
+	// MemoryStat holds the memory counters keyed by name, like "anon".
+	// memory.stat
+	MemoryStat map[string]uint64
+	// IO holds the I/O counters keyed by device number, like "8:0".
+	// io.stat
+	IO             map[string]IOStat
+	PidsCurrent    *uint64          // pids.current
+	CPUPressure    *procfs.PSIStats // cpu.pressure
+	MemoryPressure *procfs.PSIStats // memory.pressure
+	IOPressure     *procfs.PSIStats // io.pressure
+
+	// Children are the child groups, only filled in by Tree.
+	Children []*Cgroup
+}
+
+// Cgroup returns the statistics of the control group at path, relative to
+// the mount point. Children are not read.
+func (fs FS) Cgroup(path string) (*Cgroup, error) {
+	path = filepath.Join("/", path)
+	dir := fs.cgroup.Path(path)
+	cgroup := &Cgroup{Path: path}
+
+	for _, f := range [...]string{"cpu.stat", "memory.current", "memory.max", "memory.stat", "io.stat", "pids.current", "cpu.pressure", "memory.pressure", "io.pressure"} {
+		file := filepath.Join(dir, f)
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		value := strings.TrimSpace(string(data))
+
+		switch f {
+		case "cpu.stat":
+			cgroup.CPU, err = parseCPUStat(data)
+		case "memory.current":
+			cgroup.MemoryCurrent, err = parseUint(value)
+		case "memory.max":
+			if value != "max" {
+				cgroup.MemoryMax, err = parseUint(value)
+			}
+		case "memory.stat":
+			cgroup.MemoryStat, err = parseKeyedUints(data)
+		case "io.stat":
+			cgroup.IO, err = parseIOStat(data)
+		case "pids.current":
+			cgroup.PidsCurrent, err = parseUint(value)
+		case "cpu.pressure":
+			cgroup.CPUPressure, err = parsePSIStats(data)
+		case "memory.pressure":
+			cgroup.MemoryPressure, err = parsePSIStats(data)
+		case "io.pressure":
+			cgroup.IOPressure, err = parsePSIStats(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return cgroup, nil
+}
+
+// Tree returns the statistics of the control group at path and all its
+// descendants, linked through Children. Descendants removed while the tree
+// is read are left out.
+func (fs FS) Tree(path string) (*Cgroup, error) {
+	cgroup, err := fs.Cgroup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := fs.cgroup.Path(cgroup.Path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cgroup %q: %w", dir, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	if err := fs.addChildren(cgroup, names); err != nil {
+		return nil, err
+	}
+
+	return cgroup, nil
+}
+
+// addChildren reads the trees of the named child groups of cgroup. Groups
+// that no longer exist, as containers come and go, are skipped.
+func (fs FS) addChildren(cgroup *Cgroup, names []string) error {
+	for _, name := range names {
+		child, err := fs.Tree(filepath.Join(cgroup.Path, name))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		cgroup.Children = append(cgroup.Children, child)
+	}
+	return nil
+}
+
+// Walk calls fn for the group and all its descendants in depth-first order,
+// stopping at the first error.
+func (c *Cgroup) Walk(fn func(*Cgroup) error) error {
+	if err := fn(c); err != nil {
+		return err
+	}
+	for _, child := range c.Children {
+		if err := child.Walk(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseUint(value string) (*uint64, error) {
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// parseKeyedUints parses flat keyed files with lines like "anon 1234".
+func parseKeyedUints(data []byte) (map[string]uint64, error) {
+	values := map[string]uint64{}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed line: %q", line)
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line %q: %w", line, err)
+		}
+		values[fields[0]] = v
+	}
+	return values, nil
+}
+
+func parseCPUStat(data []byte) (*CPUStat, error) {
+	values, err := parseKeyedUints(data)
+	if err != nil {
+		return nil, err
+	}
+
+	stat := &CPUStat{
+		UsageUsec:  values["usage_usec"],
+		UserUsec:   values["user_usec"],
+		SystemUsec: values["system_usec"],
+	}
+	for key, v := range values {
+		switch key {
+		case "nr_periods":
+			stat.NrPeriods = &v
+		case "nr_throttled":
+			stat.NrThrottled = &v
+		case "throttled_usec":
+			stat.ThrottledUsec = &v
+		case "nr_bursts":
+			stat.NrBursts = &v
+		case "burst_usec":
+			stat.BurstUsec = &v
+		}
+	}
+	return stat, nil
+}
+
+// parseIOStat parses nested keyed lines like
+// "8:0 rbytes=1 wbytes=2 rios=3 wios=4 dbytes=0 dios=0".
+func parseIOStat(data []byte) (map[string]IOStat, error) {
+	stats := map[string]IOStat{}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var stat IOStat
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("malformed field %q in line %q", field, line)
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in line %q: %w", line, err)
+			}
+			switch key {
+			case "rbytes":
+				stat.Rbytes = v
+			case "wbytes":
+				stat.Wbytes = v
+			case "rios":
+				stat.Rios = v
+			case "wios":
+				stat.Wios = v
+			case "dbytes":
+				stat.Dbytes = v
+			case "dios":
+				stat.Dios = v
+			}
+		}
+		stats[fields[0]] = stat
+	}
+	return stats, nil
+}
+
+// parsePSIStats parses pressure stall information in the format of
+// /proc/pressure/* .
+func parsePSIStats(data []byte) (*procfs.PSIStats, error) {
+	stats := &procfs.PSIStats{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		kind, rest, _ := strings.Cut(line, " ")
+
+		psi := &procfs.PSILine{}
+		switch kind {
+		case "some":
+			stats.Some = psi
+		case "full":
+			stats.Full = psi
+		default:
+			continue
+		}
+		if _, err := fmt.Sscanf(rest, psiLineFormat, &psi.Avg10, &psi.Avg60, &psi.Avg300, &psi.Total); err != nil {
+			return nil, err
+		}
+	}
+
+	return stats, scanner.Err()
+}
@@ -0,0 +1,152 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cgroupfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/prometheus/procfs"
+)
+
+func TestCgroup(t *testing.T) {
+	fs, err := NewFS(cgroupTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.Cgroup("system.slice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Cgroup{
+		Path: "/system.slice",
+		CPU: &CPUStat{
+			UsageUsec:     412345678,
+			UserUsec:      212345678,
+			SystemUsec:    200000000,
+			NrPeriods:     makeUint64(120),
+			NrThrottled:   makeUint64(4),
+			ThrottledUsec: makeUint64(56000),
+			NrBursts:      makeUint64(0),
+			BurstUsec:     makeUint64(0),
+		},
+		MemoryCurrent: makeUint64(734003200),
+		MemoryStat: map[string]uint64{
+			"anon":    312000000,
+			"file":    400000000,
+			"kernel":  22003200,
+			"sock":    0,
+			"pgfault": 123456,
+		},
+		IO: map[string]IOStat{
+			"8:0": {Rbytes: 1000000000, Wbytes: 5000000000, Rios: 40000, Wios: 300000},
+		},
+		PidsCurrent: makeUint64(213),
+		CPUPressure: &procfs.PSIStats{
+			Some: &procfs.PSILine{Avg10: 0.05, Avg60: 0.03, Avg300: 0.01, Total: 456789},
+			Full: &procfs.PSILine{},
+		},
+		MemoryPressure: &procfs.PSIStats{
+			Some: &procfs.PSILine{},
+			Full: &procfs.PSILine{},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected cgroup (-want +got):\n%s", diff)
+	}
+}
+
+func TestTree(t *testing.T) {
+	fs, err := NewFS(cgroupTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := fs.Tree("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	memoryMax := map[string]*uint64{}
+	if err := root.Walk(func(c *Cgroup) error {
+		paths = append(paths, c.Path)
+		memoryMax[c.Path] = c.MemoryMax
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	wantPaths := []string{"/", "/system.slice", "/system.slice/sshd.service", "/user.slice"}
+	if diff := cmp.Diff(wantPaths, paths); diff != "" {
+		t.Fatalf("unexpected cgroup paths (-want +got):\n%s", diff)
+	}
+
+	wantMemoryMax := map[string]*uint64{
+		"/":                          nil,
+		"/system.slice":              nil,
+		"/system.slice/sshd.service": makeUint64(1073741824),
+		"/user.slice":                makeUint64(8589934592),
+	}
+	if diff := cmp.Diff(wantMemoryMax, memoryMax); diff != "" {
+		t.Fatalf("unexpected memory limits (-want +got):\n%s", diff)
+	}
+
+	wantIO := map[string]IOStat{
+		"8:0":   {Rbytes: 1096335360, Wbytes: 5345157120, Rios: 45672, Wios: 312098},
+		"253:0": {Rbytes: 1089536000, Wbytes: 5340119040, Rios: 45001, Wios: 311875},
+	}
+	if diff := cmp.Diff(wantIO, root.IO); diff != "" {
+		t.Fatalf("unexpected root io.stat (-want +got):\n%s", diff)
+	}
+	if root.MemoryCurrent != nil || root.PidsCurrent != nil {
+		t.Errorf("expected no memory.current and pids.current in the root cgroup")
+	}
+
+	sshd := root.Children[0].Children[0]
+	if sshd.CPU.NrPeriods != nil {
+		t.Errorf("expected no throttling counters without the cpu controller, got %v", *sshd.CPU.NrPeriods)
+	}
+}
+
+func TestTreeRemovedChild(t *testing.T) {
+	fs, err := NewFS(cgroupTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cgroup, err := fs.Cgroup("system.slice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The group was listed by its parent but removed before it was read.
+	if err := fs.addChildren(cgroup, []string{"sshd.service", "removed.scope"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, child := range cgroup.Children {
+		paths = append(paths, child.Path)
+	}
+	if diff := cmp.Diff([]string{"/system.slice/sshd.service"}, paths); diff != "" {
+		t.Fatalf("unexpected children (-want +got):\n%s", diff)
+	}
+}
@@ -31,6 +31,10 @@ const (
 
 	// DefaultSelinuxMountPoint is the common mount point of the selinuxfs.
 	DefaultSelinuxMountPoint = "/sys/fs/selinux"
+
+	// DefaultCgroupMountPoint is the common mount point of the cgroup v2
+	// filesystem.
+	DefaultCgroupMountPoint = "/sys/fs/cgroup"
 )
 
 // FS represents a pseudo-filesystem, normally /proc or /sys, which provides an
@@ -0,0 +1,94 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package procfs
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// kernelTaintFlags are the TAINT_* bits of the kernel taint mask with the
+// letters used in oops reports and /sys/module/<Name>/taint, see
+// kernel/panic.c .
+var kernelTaintFlags = []struct {
+	letter byte
+	name   string
+}{
+	{'P', "proprietary_module"},
+	{'F', "forced_module"},
+	{'S', "cpu_out_of_spec"},
+	{'R', "forced_rmmod"},
+	{'M', "machine_check"},
+	{'B', "bad_page"},
+	{'U', "user"},
+	{'D', "die"},
+	{'A', "overridden_acpi_table"},
+	{'W', "warn"},
+	{'C', "crap"},
+	{'I', "firmware_workaround"},
+	{'O', "oot_module"},
+	{'E', "unsigned_module"},
+	{'L', "softlockup"},
+	{'K', "livepatch"},
+	{'X', "aux"},
+	{'T', "randstruct"},
+	{'N', "test"},
+	{'J', "fwctl"},
+}
+
+// KernelTaint is the kernel taint mask from /proc/sys/kernel/tainted .
+// https://docs.kernel.org/admin-guide/tainted-kernels.html
+type KernelTaint uint64
+
+// KernelTaint returns the kernel taint mask read from
+// /proc/sys/kernel/tainted .
+func (fs FS) KernelTaint() (KernelTaint, error) {
+	data, err := util.ReadFileNoStat(fs.proc.Path("sys", "kernel", "tainted"))
+	if err != nil {
+		return 0, err
+	}
+	val, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return KernelTaint(val), nil
+}
+
+// Flags returns the names of the taint flags set in the mask, e.g.
+// "proprietary_module". Unknown bits are ignored.
+func (t KernelTaint) Flags() []string {
+	var flags []string
+	for i, f := range kernelTaintFlags {
+		if t&(1<<i) != 0 {
+			flags = append(flags, f.name)
+		}
+	}
+	return flags
+}
+
+// Letters returns the letters of the taint flags set in the mask in bit
+// order, like "POE". It is empty for an untainted kernel.
+func (t KernelTaint) Letters() string {
+	var letters []byte
+	for i, f := range kernelTaintFlags {
+		if t&(1<<i) != 0 {
+			letters = append(letters, f.letter)
+		}
+	}
+	return string(letters)
+}
@@ -0,0 +1,48 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package procfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKernelTaint(t *testing.T) {
+	fs, err := NewFS(procfsFixtures)
+	if err != nil {
+		t.Fatalf("failed to access %s: %v", procfsFixtures, err)
+	}
+
+	taint, err := fs.KernelTaint()
+	if err != nil {
+		t.Fatalf("failed to collect %s/sys/kernel/tainted: %v", procfsFixtures, err)
+	}
+
+	if taint != 12289 {
+		t.Errorf("tainted, want %d got %d", 12289, taint)
+	}
+	if diff := cmp.Diff([]string{"proprietary_module", "oot_module", "unsigned_module"}, taint.Flags()); diff != "" {
+		t.Errorf("unexpected taint flags (-want +got):\n%s", diff)
+	}
+	if got := taint.Letters(); got != "POE" {
+		t.Errorf("taint letters, want %q got %q", "POE", got)
+	}
+
+	if flags := KernelTaint(0).Flags(); flags != nil {
+		t.Errorf("expected no flags for an untainted kernel, got %v", flags)
+	}
+}
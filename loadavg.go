@@ -26,6 +26,15 @@ type LoadAvg struct {
 	Load1  float64
 	Load5  float64
 	Load15 float64
+	// Runnable is the number of currently runnable kernel scheduling
+	// entities (processes, threads).
+	Runnable uint64
+	// Entities is the number of kernel scheduling entities that currently
+	// exist on the system.
+	Entities uint64
+	// LastPID is the PID of the process that was most recently created on
+	// the system.
+	LastPID uint64
 }
 
 // LoadAvg returns loadavg from /proc.
@@ -39,7 +48,8 @@ func (fs FS) LoadAvg() (*LoadAvg, error) {
 	return parseLoad(data)
 }
 
-// Parse /proc loadavg and return 1m, 5m and 15m.
+// Parse /proc loadavg and return 1m, 5m and 15m as well as the scheduling
+// entity counts and last PID, which are only set if present.
 func parseLoad(loadavgBytes []byte) (*LoadAvg, error) {
 	loads := make([]float64, 3)
 	parts := strings.Fields(string(loadavgBytes))
@@ -54,9 +64,27 @@ func parseLoad(loadavgBytes []byte) (*LoadAvg, error) {
 			return nil, fmt.Errorf("%w: Cannot parse load: %f: %w", ErrFileParse, loads[i], err)
 		}
 	}
-	return &LoadAvg{
+	loadavg := &LoadAvg{
 		Load1:  loads[0],
 		Load5:  loads[1],
 		Load15: loads[2],
-	}, nil
+	}
+
+	if len(parts) < 5 {
+		return loadavg, nil
+	}
+
+	runnable, entities, ok := strings.Cut(parts[3], "/")
+	if !ok {
+		return nil, fmt.Errorf("%w: Malformed scheduling entities %q", ErrFileParse, parts[3])
+	}
+	counts, err := util.ParseUint64s([]string{runnable, entities, parts[4]})
+	if err != nil {
+		return nil, fmt.Errorf("%w: Cannot parse scheduling entities: %w", ErrFileParse, err)
+	}
+	loadavg.Runnable = counts[0]
+	loadavg.Entities = counts[1]
+	loadavg.LastPID = counts[2]
+
+	return loadavg, nil
 }
@@ -39,6 +39,12 @@ func TestLoadAvg(t *testing.T) {
 	if diff := cmp.Diff(0.05, loadavg.Load15); diff != "" {
 		t.Fatalf("unexpected LoadAvg Per fifteen minutes:\n%s", diff)
 	}
+	if diff := cmp.Diff(uint64(497), loadavg.Entities); diff != "" {
+		t.Fatalf("unexpected LoadAvg scheduling entities:\n%s", diff)
+	}
+	if diff := cmp.Diff(uint64(11947), loadavg.LastPID); diff != "" {
+		t.Fatalf("unexpected LoadAvg last PID:\n%s", diff)
+	}
 }
 
 func Test_parseLoad(t *testing.T) {
@@ -62,11 +68,27 @@ func Test_parseLoad(t *testing.T) {
 			s:    `malformed line`,
 			ok:   false,
 		},
+		{
+			name: "invalid entities",
+			s:    `0.00 0.03 0.05 1-502 33634`,
+			ok:   false,
+		},
+		{
+			name: "invalid last pid",
+			s:    `0.00 0.03 0.05 1/502 x`,
+			ok:   false,
+		},
+		{
+			name:    "loads only",
+			s:       `0.00 0.03 0.05`,
+			ok:      true,
+			loadavg: &LoadAvg{Load1: 0, Load5: 0.03, Load15: 0.05},
+		},
 		{
 			name:    "valid line",
 			s:       `0.00 0.03 0.05 1/502 33634`,
 			ok:      true,
-			loadavg: &LoadAvg{Load1: 0, Load5: 0.03, Load15: 0.05},
+			loadavg: &LoadAvg{Load1: 0, Load5: 0.03, Load15: 0.05, Runnable: 1, Entities: 502, LastPID: 33634},
 		},
 	}
 
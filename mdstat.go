@@ -28,6 +28,7 @@ var (
 	recoveryLineFinishRE = regexp.MustCompile(`finish=(.+)min`)
 	recoveryLineSpeedRE  = regexp.MustCompile(`speed=(.+)[A-Z]`)
 	componentDeviceRE    = regexp.MustCompile(`(.*)\[(\d+)\](\([SF]+\))?`)
+	bitmapLineRE         = regexp.MustCompile(`bitmap: (\d+)/(\d+) pages \[(\d+)KB\], (\d+)(KB|B) chunk(?:, file: (.+))?`)
 	personalitiesPrefix  = "Personalities : "
 )
 
@@ -81,6 +82,48 @@ type MDStat struct {
 	BlocksSyncedSpeed float64
 	// component devices
 	Devices []MDStatComponent
+	// write-intent bitmap, nil if the device has none
+	Bitmap *MDStatBitmap
+}
+
+// MDStatBitmap holds the state of the write-intent bitmap of an md device,
+// from a line like "bitmap: 4/15 pages [16KB], 65536KB chunk".
+type MDStatBitmap struct {
+	// Number of bitmap pages allocated in memory.
+	PagesAllocated int64
+	// Total number of bitmap pages.
+	PagesTotal int64
+	// Memory used by the allocated pages in bytes.
+	MemoryBytes int64
+	// Amount of data tracked by a single bit in bytes.
+	ChunkBytes int64
+	// Path of the bitmap file for external bitmaps, empty for internal ones.
+	File string
+}
+
+// MDStatPersonalities returns the RAID personalities registered with the
+// kernel, like "raid1", from the "Personalities" line of /proc/mdstat.
+func (fs FS) MDStatPersonalities() ([]string, error) {
+	data, err := os.ReadFile(fs.proc.Path("mdstat"))
+	if err != nil {
+		return nil, err
+	}
+	return parseMDStatPersonalities(data), nil
+}
+
+func parseMDStatPersonalities(mdStatData []byte) []string {
+	personalities := []string{}
+	for line := range strings.SplitSeq(string(mdStatData), "\n") {
+		rest, ok := strings.CutPrefix(line, personalitiesPrefix)
+		if !ok {
+			continue
+		}
+		for _, word := range strings.Fields(rest) {
+			personalities = append(personalities, strings.Trim(word, "[]"))
+		}
+		break
+	}
+	return personalities
 }
 
 // MDStat parses an mdstat-file (/proc/mdstat) and returns a slice of
@@ -155,9 +198,21 @@ func parseMDStat(mdStatData []byte) ([]MDStat, error) {
 			return nil, fmt.Errorf("%w: Cannot parse md device lines: %v: %w", ErrFileParse, active, err)
 		}
 
+		// The bitmap line may come before or after the sync line.
+		var bitmap *MDStatBitmap
 		syncLineIdx := i + 2
-		if strings.Contains(lines[i+2], "bitmap") { // skip bitmap line
-			syncLineIdx++
+		for _, idx := range []int{i + 2, i + 3} {
+			if !strings.HasPrefix(strings.TrimSpace(lines[idx]), "bitmap:") {
+				continue
+			}
+			bitmap, err = evalBitmapLine(lines[idx])
+			if err != nil {
+				return nil, fmt.Errorf("%w: Cannot parse bitmap line in md device: %q: %w", ErrFileParse, mdName, err)
+			}
+			if idx == i+2 {
+				syncLineIdx++
+			}
+			break
 		}
 
 		// If device is syncing at the moment, get the number of currently
@@ -218,6 +273,7 @@ func parseMDStat(mdStatData []byte) ([]MDStat, error) {
 			BlocksSyncedFinishTime: finish,
 			BlocksSyncedSpeed:      speed,
 			Devices:                devices,
+			Bitmap:                 bitmap,
 		})
 	}
 
@@ -325,6 +381,31 @@ func evalRecoveryLine(recoveryLine string) (blocksSynced int64, blocksToBeSynced
 	return blocksSynced, blocksToBeSynced, pct, finish, speed, nil
 }
 
+func evalBitmapLine(bitmapLine string) (*MDStatBitmap, error) {
+	matches := bitmapLineRE.FindStringSubmatch(bitmapLine)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: Unexpected bitmap line %q", ErrFileParse, bitmapLine)
+	}
+
+	var (
+		bitmap MDStatBitmap
+		err    error
+	)
+	for i, v := range []*int64{&bitmap.PagesAllocated, &bitmap.PagesTotal, &bitmap.MemoryBytes, &bitmap.ChunkBytes} {
+		*v, err = strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: Unexpected bitmap line %q: %w", ErrFileParse, bitmapLine, err)
+		}
+	}
+	bitmap.MemoryBytes *= 1024
+	if matches[5] == "KB" {
+		bitmap.ChunkBytes *= 1024
+	}
+	bitmap.File = matches[6]
+
+	return &bitmap, nil
+}
+
 func evalComponentDevices(deviceFields []string) ([]MDStatComponent, error) {
 	mdComponentDevices := make([]MDStatComponent, 0)
 	for _, field := range deviceFields {
@@ -144,7 +144,8 @@ func TestFS_MDStat(t *testing.T) {
 			BlocksSyncedPct:        0,
 			BlocksSyncedFinishTime: 0,
 			BlocksSyncedSpeed:      0,
-			Devices:                []MDStatComponent{{Name: "sdb1", DescriptorIndex: 0}, {Name: "sde1", DescriptorIndex: 3}, {Name: "sdd1", DescriptorIndex: 2}, {Name: "sdc1", DescriptorIndex: 1, Faulty: true}}},
+			Devices:                []MDStatComponent{{Name: "sdb1", DescriptorIndex: 0}, {Name: "sde1", DescriptorIndex: 3}, {Name: "sdd1", DescriptorIndex: 2}, {Name: "sdc1", DescriptorIndex: 1, Faulty: true}},
+			Bitmap:                 &MDStatBitmap{PagesAllocated: 0, PagesTotal: 30, MemoryBytes: 0, ChunkBytes: 65536 * 1024}},
 		"md9": {
 			Name:                   "md9",
 			Type:                   "raid1",
@@ -320,7 +321,8 @@ func TestFS_MDStat(t *testing.T) {
 			BlocksSyncedPct:        56.1,
 			BlocksSyncedFinishTime: 1868.1,
 			BlocksSyncedSpeed:      7640,
-			Devices:                []MDStatComponent{{Name: "sda1", DescriptorIndex: 3, Spare: true}, {Name: "sdd1", DescriptorIndex: 0}, {Name: "sde1", DescriptorIndex: 1}}},
+			Devices:                []MDStatComponent{{Name: "sda1", DescriptorIndex: 3, Spare: true}, {Name: "sdd1", DescriptorIndex: 0}, {Name: "sde1", DescriptorIndex: 1}},
+			Bitmap:                 &MDStatBitmap{PagesAllocated: 4, PagesTotal: 15, MemoryBytes: 16 * 1024, ChunkBytes: 65536 * 1024}},
 	}
 
 	if want, have := len(refs), len(mdStats); want != have {
@@ -334,6 +336,33 @@ func TestFS_MDStat(t *testing.T) {
 
 }
 
+func TestFS_MDStatPersonalities(t *testing.T) {
+	personalities, err := getProcFixtures(t).MDStatPersonalities()
+	if err != nil {
+		t.Fatalf("parsing of reference-file failed entirely: %s", err)
+	}
+
+	want := []string{"linear", "multipath", "raid0", "raid1", "raid6", "raid5", "raid4", "raid10"}
+	if diff := cmp.Diff(want, personalities); diff != "" {
+		t.Fatalf("unexpected personalities (-want +got):\n%s", diff)
+	}
+}
+
+func TestEvalBitmapLine(t *testing.T) {
+	bitmap, err := evalBitmapLine("      bitmap: 1/1 pages [4KB], 512B chunk, file: /var/md/bitmap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &MDStatBitmap{PagesAllocated: 1, PagesTotal: 1, MemoryBytes: 4096, ChunkBytes: 512, File: "/var/md/bitmap"}
+	if diff := cmp.Diff(want, bitmap); diff != "" {
+		t.Fatalf("unexpected bitmap (-want +got):\n%s", diff)
+	}
+
+	if _, err := evalBitmapLine("      bitmap: garbage"); err == nil {
+		t.Fatal("parsing of invalid bitmap line did not fail")
+	}
+}
+
 func TestInvalidMdstat(t *testing.T) {
 	invalidMount := [][]byte{
 		// Test invalid Personality and format
@@ -61,6 +61,15 @@ type Meminfo struct {
 	InactiveFile *uint64
 	Unevictable  *uint64
 	Mlocked      *uint64
+	// Highmem and lowmem totals and free amounts, only present on
+	// 32-bit kernels with CONFIG_HIGHMEM.
+	HighTotal *uint64
+	HighFree  *uint64
+	LowTotal  *uint64
+	LowFree   *uint64
+	// Memory used by private file mappings on kernels without an
+	// MMU.
+	MmapCopy *uint64
 	// total amount of swap space available
 	SwapTotal *uint64
 	// Memory which has been evicted from RAM, and is temporarily
@@ -79,6 +88,10 @@ type Meminfo struct {
 	// files which have been mapped, such as libraries
 	Mapped *uint64
 	Shmem  *uint64
+	// Kernel allocations that the kernel will attempt to reclaim
+	// under memory pressure. Includes SReclaimable and other direct
+	// allocations with a shrinker.
+	KReclaimable *uint64
 	// in-kernel data structures cache
 	Slab *uint64
 	// Part of Slab, that might be reclaimed, such as caches
@@ -86,6 +99,9 @@ type Meminfo struct {
 	// Part of Slab, that cannot be reclaimed on memory pressure
 	SUnreclaim  *uint64
 	KernelStack *uint64
+	// Memory used by the shadow call stack, only present with
+	// CONFIG_SHADOW_CALL_STACK.
+	ShadowCallStack *uint64
 	// amount of memory dedicated to the lowest level of page
 	// tables.
 	PageTables *uint64
@@ -133,14 +149,17 @@ type Meminfo struct {
 	// largest contiguous block of vmalloc area which is free
 	VmallocChunk      *uint64
 	Percpu            *uint64
+	EarlyMemtestBad   *uint64
 	HardwareCorrupted *uint64
 	AnonHugePages     *uint64
 	FileHugePages     *uint64
+	FilePmdMapped     *uint64
 	ShmemHugePages    *uint64
 	ShmemPmdMapped    *uint64
 	CmaTotal          *uint64
 	CmaFree           *uint64
 	Unaccepted        *uint64
+	Balloon           *uint64
 	HugePagesTotal    *uint64
 	HugePagesFree     *uint64
 	HugePagesRsvd     *uint64
@@ -168,6 +187,11 @@ type Meminfo struct {
 	InactiveFileBytes      *uint64
 	UnevictableBytes       *uint64
 	MlockedBytes           *uint64
+	HighTotalBytes         *uint64
+	HighFreeBytes          *uint64
+	LowTotalBytes          *uint64
+	LowFreeBytes           *uint64
+	MmapCopyBytes          *uint64
 	SwapTotalBytes         *uint64
 	SwapFreeBytes          *uint64
 	ZswapBytes             *uint64
@@ -177,10 +201,12 @@ type Meminfo struct {
 	AnonPagesBytes         *uint64
 	MappedBytes            *uint64
 	ShmemBytes             *uint64
+	KReclaimableBytes      *uint64
 	SlabBytes              *uint64
 	SReclaimableBytes      *uint64
 	SUnreclaimBytes        *uint64
 	KernelStackBytes       *uint64
+	ShadowCallStackBytes   *uint64
 	PageTablesBytes        *uint64
 	SecPageTablesBytes     *uint64
 	NFSUnstableBytes       *uint64
@@ -192,14 +218,17 @@ type Meminfo struct {
 	VmallocUsedBytes       *uint64
 	VmallocChunkBytes      *uint64
 	PercpuBytes            *uint64
+	EarlyMemtestBadBytes   *uint64
 	HardwareCorruptedBytes *uint64
 	AnonHugePagesBytes     *uint64
 	FileHugePagesBytes     *uint64
+	FilePmdMappedBytes     *uint64
 	ShmemHugePagesBytes    *uint64
 	ShmemPmdMappedBytes    *uint64
 	CmaTotalBytes          *uint64
 	CmaFreeBytes           *uint64
 	UnacceptedBytes        *uint64
+	BalloonBytes           *uint64
 	HugepagesizeBytes      *uint64
 	HugetlbBytes           *uint64
 	DirectMap4kBytes       *uint64
@@ -228,6 +257,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 	s := bufio.NewScanner(r)
 	for s.Scan() {
 		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%w: Malformed line %q", ErrFileParse, s.Text())
+		}
 		var val, valBytes uint64
 
 		val, err := strconv.ParseUint(fields[1], 0, 64)
@@ -296,6 +328,21 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "Mlocked:":
 			m.Mlocked = &val
 			m.MlockedBytes = &valBytes
+		case "HighTotal:":
+			m.HighTotal = &val
+			m.HighTotalBytes = &valBytes
+		case "HighFree:":
+			m.HighFree = &val
+			m.HighFreeBytes = &valBytes
+		case "LowTotal:":
+			m.LowTotal = &val
+			m.LowTotalBytes = &valBytes
+		case "LowFree:":
+			m.LowFree = &val
+			m.LowFreeBytes = &valBytes
+		case "MmapCopy:":
+			m.MmapCopy = &val
+			m.MmapCopyBytes = &valBytes
 		case "SwapTotal:":
 			m.SwapTotal = &val
 			m.SwapTotalBytes = &valBytes
@@ -323,6 +370,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "Shmem:":
 			m.Shmem = &val
 			m.ShmemBytes = &valBytes
+		case "KReclaimable:":
+			m.KReclaimable = &val
+			m.KReclaimableBytes = &valBytes
 		case "Slab:":
 			m.Slab = &val
 			m.SlabBytes = &valBytes
@@ -335,6 +385,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "KernelStack:":
 			m.KernelStack = &val
 			m.KernelStackBytes = &valBytes
+		case "ShadowCallStack:":
+			m.ShadowCallStack = &val
+			m.ShadowCallStackBytes = &valBytes
 		case "PageTables:":
 			m.PageTables = &val
 			m.PageTablesBytes = &valBytes
@@ -368,6 +421,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "Percpu:":
 			m.Percpu = &val
 			m.PercpuBytes = &valBytes
+		case "EarlyMemtestBad:":
+			m.EarlyMemtestBad = &val
+			m.EarlyMemtestBadBytes = &valBytes
 		case "HardwareCorrupted:":
 			m.HardwareCorrupted = &val
 			m.HardwareCorruptedBytes = &valBytes
@@ -377,6 +433,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "FileHugePages:":
 			m.FileHugePages = &val
 			m.FileHugePagesBytes = &valBytes
+		case "FilePmdMapped:":
+			m.FilePmdMapped = &val
+			m.FilePmdMappedBytes = &valBytes
 		case "ShmemHugePages:":
 			m.ShmemHugePages = &val
 			m.ShmemHugePagesBytes = &valBytes
@@ -392,6 +451,9 @@ func parseMemInfo(r io.Reader) (*Meminfo, error) {
 		case "Unaccepted:":
 			m.Unaccepted = &val
 			m.UnacceptedBytes = &valBytes
+		case "Balloon:":
+			m.Balloon = &val
+			m.BalloonBytes = &valBytes
 		case "HugePages_Total:":
 			m.HugePagesTotal = &val
 		case "HugePages_Free:":
@@ -14,6 +14,7 @@
 package procfs
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -119,3 +120,53 @@ func TestMeminfo(t *testing.T) {
 		t.Fatalf("unexpected meminfo entry (-want +got):\n%s", diff)
 	}
 }
+
+func TestParseMeminfoOptionalFields(t *testing.T) {
+	const data = `KReclaimable:     412088 kB
+ShadowCallStack:     768 kB
+EarlyMemtestBad:       0 kB
+FilePmdMapped:      4096 kB
+Balloon:          524288 kB
+HighTotal:        917504 kB
+HighFree:         102400 kB
+LowTotal:         131072 kB
+LowFree:           65536 kB
+MmapCopy:              8 kB
+`
+	want := &Meminfo{
+		KReclaimable:    newuint64(412088),
+		ShadowCallStack: newuint64(768),
+		EarlyMemtestBad: newuint64(0),
+		FilePmdMapped:   newuint64(4096),
+		Balloon:         newuint64(524288),
+		HighTotal:       newuint64(917504),
+		HighFree:        newuint64(102400),
+		LowTotal:        newuint64(131072),
+		LowFree:         newuint64(65536),
+		MmapCopy:        newuint64(8),
+
+		KReclaimableBytes:    newuint64(421978112),
+		ShadowCallStackBytes: newuint64(786432),
+		EarlyMemtestBadBytes: newuint64(0),
+		FilePmdMappedBytes:   newuint64(4194304),
+		BalloonBytes:         newuint64(536870912),
+		HighTotalBytes:       newuint64(939524096),
+		HighFreeBytes:        newuint64(104857600),
+		LowTotalBytes:        newuint64(134217728),
+		LowFreeBytes:         newuint64(67108864),
+		MmapCopyBytes:        newuint64(8192),
+	}
+
+	got, err := parseMemInfo(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected meminfo entry (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseMemInfo(strings.NewReader("MemTotal:\n")); err == nil {
+		t.Fatal("expected error for line without value")
+	}
+}
@@ -36,9 +36,10 @@ type MountInfo struct {
 	// The value of `st_dev` for the files on this FS
 	MajorMinorVer string
 	// The pathname of the directory in the FS that forms
-	// the root for this mount
+	// the root for this mount. Octal escapes like \040 are decoded.
 	Root string
-	// The pathname of the mount point relative to the root
+	// The pathname of the mount point relative to the root. Octal escapes
+	// like \040 are decoded.
 	MountPoint string
 	// Mount options
 	Options map[string]string
@@ -46,7 +47,8 @@ type MountInfo struct {
 	OptionalFields map[string]string
 	// The Filesystem type
 	FSType string
-	// FS specific information or "none"
+	// FS specific information or "none". Octal escapes like \040 are
+	// decoded.
 	Source string
 	// Superblock options
 	SuperOptions map[string]string
@@ -87,22 +89,22 @@ func parseMountInfoString(mountString string) (*MountInfo, error) {
 
 	mount := &MountInfo{
 		MajorMinorVer:  mountInfo[2],
-		Root:           mountInfo[3],
-		MountPoint:     mountInfo[4],
+		Root:           unescapeOctal(mountInfo[3]),
+		MountPoint:     unescapeOctal(mountInfo[4]),
 		Options:        mountOptionsParser(mountInfo[5]),
 		OptionalFields: nil,
 		FSType:         mountInfo[mountInfoLength-3],
-		Source:         mountInfo[mountInfoLength-2],
+		Source:         unescapeOctal(mountInfo[mountInfoLength-2]),
 		SuperOptions:   mountOptionsParser(mountInfo[mountInfoLength-1]),
 	}
 
 	mount.MountID, err = strconv.Atoi(mountInfo[0])
 	if err != nil {
-		return nil, fmt.Errorf("%w: mount ID: %q", ErrFileParse, mount.MountID)
+		return nil, fmt.Errorf("%w: mount ID %q: %w", ErrFileParse, mountInfo[0], err)
 	}
 	mount.ParentID, err = strconv.Atoi(mountInfo[1])
 	if err != nil {
-		return nil, fmt.Errorf("%w: parent ID: %q", ErrFileParse, mount.ParentID)
+		return nil, fmt.Errorf("%w: parent ID %q: %w", ErrFileParse, mountInfo[1], err)
 	}
 	// Has optional fields, which is a space separated list of values.
 	// Example: shared:2 master:7
@@ -195,3 +197,8 @@ func (fs FS) GetProcMounts(pid int) ([]*MountInfo, error) {
 	}
 	return parseMountInfo(data)
 }
+
+// MountInfo retrieves mountinfo information from `/proc/self/mountinfo`.
+func (fs FS) MountInfo() ([]*MountInfo, error) {
+	return fs.GetMounts()
+}
@@ -42,6 +42,23 @@ func TestMountInfo(t *testing.T) {
 				SuperOptions:   map[string]string{"rw": ""},
 			},
 		},
+		{
+			name:    "Mount point with escaped whitespace",
+			s:       "83 21 8:17 / /mnt/my\\040disk rw,relatime master:3 - ext4 /dev/sdb1 rw",
+			invalid: false,
+			mount: &MountInfo{
+				MountID:        83,
+				ParentID:       21,
+				MajorMinorVer:  "8:17",
+				Root:           "/",
+				MountPoint:     "/mnt/my disk",
+				Options:        map[string]string{"rw": "", "relatime": ""},
+				OptionalFields: map[string]string{"master": "3"},
+				FSType:         "ext4",
+				Source:         "/dev/sdb1",
+				SuperOptions:   map[string]string{"rw": ""},
+			},
+		},
 		{
 			name:    "Not enough information",
 			s:       "hello",
@@ -359,6 +376,28 @@ func TestFSMountInfo(t *testing.T) {
 		t.Fatalf("unexpected mountpoints (-want +got):\n%s", diff)
 	}
 
+	got, err = fs.MountInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected mountpoints (-want +got):\n%s", diff)
+	}
+
+	p, err := fs.Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = p.MountInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected process mountpoints (-want +got):\n%s", diff)
+	}
+
 	got, err = fs.GetProcMounts(26231)
 	if err != nil {
 		t.Fatal(err)
@@ -41,11 +41,45 @@ type ConntrackStatEntry struct {
 	SearchRestart uint64
 }
 
+// ConntrackTable contains the size and limit of the netfilter connection
+// tracking table from /proc/sys/net/netfilter .
+type ConntrackTable struct {
+	// Count is the number of tracked connections.
+	Count uint64
+	// Max is the maximum number of tracked connections. New connections are
+	// dropped once Count reaches it.
+	Max uint64
+}
+
 // ConntrackStat retrieves netfilter's conntrack statistics, split by CPU cores.
 func (fs FS) ConntrackStat() ([]ConntrackStatEntry, error) {
 	return readConntrackStat(fs.proc.Path("net", "stat", "nf_conntrack"))
 }
 
+// ConntrackTable retrieves the number of tracked connections and the table
+// limit from /proc/sys/net/netfilter/nf_conntrack_{count,max}. The files
+// only exist while the nf_conntrack module is loaded.
+func (fs FS) ConntrackTable() (*ConntrackTable, error) {
+	count, err := util.ReadUintFromFile(fs.proc.Path("sys", "net", "netfilter", "nf_conntrack_count"))
+	if err != nil {
+		return nil, err
+	}
+	limit, err := util.ReadUintFromFile(fs.proc.Path("sys", "net", "netfilter", "nf_conntrack_max"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConntrackTable{Count: count, Max: limit}, nil
+}
+
+// Utilization returns the fraction of the table in use, between 0 and 1.
+func (t ConntrackTable) Utilization() float64 {
+	if t.Max == 0 {
+		return 0
+	}
+	return float64(t.Count) / float64(t.Max)
+}
+
 // Parses a slice of ConntrackStatEntries from the given filepath.
 func readConntrackStat(path string) ([]ConntrackStatEntry, error) {
 	// This file is small and can be read with one syscall.
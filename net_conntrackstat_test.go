@@ -117,3 +117,22 @@ func TestParseOldConntrackStat(t *testing.T) {
 		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
 	}
 }
+
+func TestConntrackTable(t *testing.T) {
+	table, err := getProcFixtures(t).ConntrackTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ConntrackTable{Count: 49152, Max: 262144}
+	if diff := cmp.Diff(want, table); diff != "" {
+		t.Fatalf("unexpected conntrack table (-want +got):\n%s", diff)
+	}
+
+	if want, got := 0.1875, table.Utilization(); want != got {
+		t.Errorf("want utilization %f, got %f", want, got)
+	}
+	if got := (ConntrackTable{Count: 1}).Utilization(); got != 0 {
+		t.Errorf("want zero utilization without limit, got %f", got)
+	}
+}
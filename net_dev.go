@@ -16,6 +16,7 @@ package procfs
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"os"
 	"sort"
 	"strconv"
@@ -92,6 +93,9 @@ func (netDev NetDev) parseLine(rawLine string) (*NetDevLine, error) {
 		return nil, errors.New("invalid net/dev line, missing colon")
 	}
 	fields := strings.Fields(strings.TrimSpace(rawLine[idx+1:]))
+	if len(fields) != 16 {
+		return nil, fmt.Errorf("%w: invalid net/dev line, expected 16 counters but got %d: %q", ErrFileParse, len(fields), rawLine)
+	}
 
 	var err error
 	line := &NetDevLine{}
@@ -32,6 +32,16 @@ func TestNetDevParseLine(t *testing.T) {
 		}
 	}
 
+	for _, rawLine := range []string{
+		"  eth0: 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15",
+		"  eth0:",
+		"  eth0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16",
+		"  : 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16",
+	} {
+		if _, err := (NetDev{}).parseLine(rawLine); err == nil {
+			t.Errorf("expected error parsing %q", rawLine)
+		}
+	}
 }
 
 func TestNetDev(t *testing.T) {
@@ -16,8 +16,11 @@ package procfs
 import (
 	"bufio"
 	"bytes"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"net"
 	"strconv"
 	"strings"
 
@@ -28,6 +31,25 @@ const (
 	blackholeRepresentation string = "*"
 	blackholeIfaceName      string = "blackhole"
 	routeLineColumns        int    = 11
+	ipv6RouteLineColumns    int    = 10
+)
+
+// Route flags from include/uapi/linux/route.h.
+const (
+	// Route usable.
+	RTFUp = 0x0001
+	// Destination is a gateway.
+	RTFGateway = 0x0002
+	// Host entry (net otherwise).
+	RTFHost = 0x0004
+	// Reinstate route after timeout.
+	RTFReinstate = 0x0008
+	// Created dyn. (by redirect).
+	RTFDynamic = 0x0010
+	// Modified dyn. (by redirect).
+	RTFModified = 0x0020
+	// Reject route.
+	RTFReject = 0x0200
 )
 
 // A NetRouteLine represents one line from net/route.
@@ -45,6 +67,21 @@ type NetRouteLine struct {
 	IRTT        uint32
 }
 
+// A NetIPv6RouteLine represents one line from net/ipv6_route.
+type NetIPv6RouteLine struct {
+	Destination          net.IP
+	DestinationPrefixLen uint8
+	Source               net.IP
+	SourcePrefixLen      uint8
+	NextHop              net.IP
+	Metric               uint32
+	RefCnt               uint32
+	Use                  uint32
+	Flags                uint32
+	Iface                string
+}
+
+// NetRoute returns the IPv4 routing table read from /proc/net/route.
 func (fs FS) NetRoute() ([]NetRouteLine, error) {
 	return readNetRoute(fs.proc.Path("net", "route"))
 }
@@ -75,7 +112,7 @@ func parseNetRoute(r io.Reader) ([]NetRouteLine, error) {
 		}
 		routelines = append(routelines, *routeline)
 	}
-	return routelines, nil
+	return routelines, scanner.Err()
 }
 
 func parseNetRouteLine(fields []string) (*NetRouteLine, error) {
@@ -94,7 +131,7 @@ func parseNetRouteLine(fields []string) (*NetRouteLine, error) {
 	if err != nil {
 		return nil, err
 	}
-	flags, err := strconv.ParseUint(fields[3], 10, 32)
+	flags, err := strconv.ParseUint(fields[3], 16, 32)
 	if err != nil {
 		return nil, err
 	}
@@ -141,3 +178,116 @@ func parseNetRouteLine(fields []string) (*NetRouteLine, error) {
 	}
 	return routeline, nil
 }
+
+// DestinationIP returns the destination network address.
+func (r NetRouteLine) DestinationIP() net.IP {
+	return routeIPv4(r.Destination)
+}
+
+// GatewayIP returns the gateway address, 0.0.0.0 for directly connected
+// routes.
+func (r NetRouteLine) GatewayIP() net.IP {
+	return routeIPv4(r.Gateway)
+}
+
+// MaskIP returns the destination netmask.
+func (r NetRouteLine) MaskIP() net.IPMask {
+	return net.IPMask(routeIPv4(r.Mask).To4())
+}
+
+// IsDefault returns true for a default route.
+func (r NetRouteLine) IsDefault() bool {
+	return r.Destination == 0 && r.Mask == 0
+}
+
+// routeIPv4 converts an address from /proc/net/route, which the kernel
+// prints as a host-endian integer of the network-order bytes.
+func routeIPv4(v uint32) net.IP {
+	b := make([]byte, net.IPv4len)
+	binary.NativeEndian.PutUint32(b, v)
+	return net.IPv4(b[0], b[1], b[2], b[3])
+}
+
+// NetIPv6Route returns the IPv6 routing table read from /proc/net/ipv6_route.
+func (fs FS) NetIPv6Route() ([]NetIPv6RouteLine, error) {
+	path := fs.proc.Path("net", "ipv6_route")
+	b, err := util.ReadFileNoStat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	routelines, err := parseNetIPv6Route(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read net ipv6 route from %s: %w", path, err)
+	}
+	return routelines, nil
+}
+
+// parseNetIPv6Route parses /proc/net/ipv6_route, which has no header line.
+func parseNetIPv6Route(r io.Reader) ([]NetIPv6RouteLine, error) {
+	var routelines []NetIPv6RouteLine
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		routeline, err := parseNetIPv6RouteLine(fields)
+		if err != nil {
+			return nil, err
+		}
+		routelines = append(routelines, *routeline)
+	}
+	return routelines, scanner.Err()
+}
+
+func parseNetIPv6RouteLine(fields []string) (*NetIPv6RouteLine, error) {
+	if len(fields) != ipv6RouteLineColumns {
+		return nil, fmt.Errorf("invalid ipv6 routeline, num of digits: %d", len(fields))
+	}
+
+	var ips [3]net.IP
+	for i, f := range []string{fields[0], fields[2], fields[4]} {
+		ip, err := hex.DecodeString(f)
+		if err != nil {
+			return nil, err
+		}
+		if len(ip) != net.IPv6len {
+			return nil, fmt.Errorf("invalid ipv6 address %q", f)
+		}
+		ips[i] = net.IP(ip)
+	}
+
+	var prefixLens [2]uint8
+	for i, f := range []string{fields[1], fields[3]} {
+		v, err := strconv.ParseUint(f, 16, 8)
+		if err != nil {
+			return nil, err
+		}
+		prefixLens[i] = uint8(v)
+	}
+
+	values, err := parseHexUint32s(fields[5:9])
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetIPv6RouteLine{
+		Destination:          ips[0],
+		DestinationPrefixLen: prefixLens[0],
+		Source:               ips[1],
+		SourcePrefixLen:      prefixLens[1],
+		NextHop:              ips[2],
+		Metric:               values[0],
+		RefCnt:               values[1],
+		Use:                  values[2],
+		Flags:                values[3],
+		Iface:                fields[9],
+	}, nil
+}
+
+// IsDefault returns true for a default route.
+func (r NetIPv6RouteLine) IsDefault() bool {
+	return r.DestinationPrefixLen == 0 && r.Destination.IsUnspecified()
+}
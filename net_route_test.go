@@ -15,6 +15,7 @@ package procfs
 
 import (
 	"bytes"
+	"net"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -59,3 +60,105 @@ eno16780032      0000A8C0     00000000  0001   0       0    100     0000FFFF  0
 		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
 	}
 }
+
+func TestNetRoute(t *testing.T) {
+	routes, err := getProcFixtures(t).NetRoute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(routes) != 3 {
+		t.Fatalf("want 3 routes, got %d", len(routes))
+	}
+
+	def := routes[0]
+	if !def.IsDefault() {
+		t.Error("expected first route to be the default route")
+	}
+	if want, got := "192.168.1.1", def.GatewayIP().String(); want != got {
+		t.Errorf("want gateway %s, got %s", want, got)
+	}
+
+	network := routes[1]
+	if network.IsDefault() {
+		t.Error("expected second route not to be a default route")
+	}
+	if want, got := "192.168.1.0", network.DestinationIP().String(); want != got {
+		t.Errorf("want destination %s, got %s", want, got)
+	}
+	if want, got := 24, maskOnes(network.MaskIP()); want != got {
+		t.Errorf("want prefix length %d, got %d", want, got)
+	}
+
+	host := routes[2]
+	if want, got := "172.16.0.10", host.DestinationIP().String(); want != got {
+		t.Errorf("want destination %s, got %s", want, got)
+	}
+	if want := uint32(RTFUp | RTFGateway | RTFHost | RTFDynamic); host.Flags != want {
+		t.Errorf("want flags %#x, got %#x", want, host.Flags)
+	}
+}
+
+func maskOnes(m net.IPMask) int {
+	ones, _ := m.Size()
+	return ones
+}
+
+func TestNetIPv6Route(t *testing.T) {
+	routes, err := getProcFixtures(t).NetIPv6Route()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []NetIPv6RouteLine{
+		{
+			Destination:          net.ParseIP("2001:db8::"),
+			DestinationPrefixLen: 64,
+			Source:               net.IPv6unspecified,
+			NextHop:              net.IPv6unspecified,
+			Metric:               256,
+			RefCnt:               1,
+			Flags:                RTFUp,
+			Iface:                "eth0",
+		},
+		{
+			Destination:          net.ParseIP("fe80::"),
+			DestinationPrefixLen: 64,
+			Source:               net.IPv6unspecified,
+			NextHop:              net.IPv6unspecified,
+			Metric:               256,
+			RefCnt:               1,
+			Flags:                RTFUp,
+			Iface:                "eth0",
+		},
+		{
+			Destination: net.IPv6unspecified,
+			Source:      net.IPv6unspecified,
+			NextHop:     net.ParseIP("fe80::1"),
+			Metric:      1024,
+			RefCnt:      1,
+			Flags:       0x00450003,
+			Iface:       "eth0",
+		},
+		{
+			Destination:          net.IPv6loopback,
+			DestinationPrefixLen: 128,
+			Source:               net.IPv6unspecified,
+			NextHop:              net.IPv6unspecified,
+			RefCnt:               2,
+			Flags:                0x80200001,
+			Iface:                "lo",
+		},
+	}
+	if diff := cmp.Diff(want, routes); diff != "" {
+		t.Fatalf("unexpected IPv6 routes (-want +got):\n%s", diff)
+	}
+
+	if !routes[2].IsDefault() || routes[0].IsDefault() {
+		t.Error("expected only the third route to be a default route")
+	}
+
+	if _, err := parseNetIPv6Route(bytes.NewReader([]byte("2001 40 eth0\n"))); err == nil {
+		t.Error("expected error for truncated line")
+	}
+}
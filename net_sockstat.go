@@ -40,8 +40,22 @@ type NetSockstatProtocol struct {
 	Orphan   *int
 	TW       *int
 	Alloc    *int
-	Mem      *int
-	Memory   *int
+	// Mem is the memory used by the protocol's socket buffers in pages, the
+	// unit of the net.ipv4.tcp_mem and net.ipv4.udp_mem limits.
+	Mem *int
+	// Memory is the memory used by IP fragment reassembly in bytes.
+	Memory *int
+}
+
+// Protocol returns the statistics of the named protocol, like "TCP" or
+// "UDP6", and whether it was present.
+func (s NetSockstat) Protocol(name string) (NetSockstatProtocol, bool) {
+	for _, p := range s.Protocols {
+		if p.Protocol == name {
+			return p, true
+		}
+	}
+	return NetSockstatProtocol{}, false
 }
 
 // NetSockstat retrieves IPv4 socket statistics.
@@ -57,6 +71,21 @@ func (fs FS) NetSockstat6() (*NetSockstat, error) {
 	return readSockstat(fs.proc.Path("net", "sockstat6"))
 }
 
+// NetSockstat retrieves IPv4 socket statistics from the network namespace of
+// the process.
+func (p Proc) NetSockstat() (*NetSockstat, error) {
+	return readSockstat(p.path("net", "sockstat"))
+}
+
+// NetSockstat6 retrieves IPv6 socket statistics from the network namespace of
+// the process.
+//
+// If IPv6 is disabled on this kernel, the returned error can be checked with
+// os.IsNotExist.
+func (p Proc) NetSockstat6() (*NetSockstat, error) {
+	return readSockstat(p.path("net", "sockstat6"))
+}
+
 // readSockstat opens and parses a NetSockstat from the input file.
 func readSockstat(name string) (*NetSockstat, error) {
 	// This file is small and can be read with one syscall.
@@ -50,6 +50,17 @@ func TestNetSockstat(t *testing.T) {
 	if diff := cmp.Diff(35, stat.Protocols[0].InUse); diff != "" {
 		t.Fatalf("unexpected number of TCP sockets (-want +got):\n%s", diff)
 	}
+
+	udp, ok := stat.Protocol("UDP")
+	if !ok {
+		t.Fatal("UDP protocol not found")
+	}
+	if udp.Mem == nil || *udp.Mem != 62 {
+		t.Fatalf("unexpected UDP memory pages: %v", udp.Mem)
+	}
+	if _, ok := stat.Protocol("SCTP"); ok {
+		t.Fatal("unexpected SCTP protocol")
+	}
 }
 
 func TestNetSockstat6(t *testing.T) {
@@ -77,6 +88,30 @@ func TestNetSockstat6(t *testing.T) {
 	}
 }
 
+func TestProcNetSockstat(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stat, err := p.NetSockstat()
+	if err != nil {
+		t.Fatalf("failed to get sockstat: %v", err)
+	}
+
+	tcp, ok := stat.Protocol("TCP")
+	if !ok {
+		t.Fatal("TCP protocol not found")
+	}
+	if diff := cmp.Diff(2, tcp.InUse); diff != "" {
+		t.Fatalf("unexpected number of TCP sockets (-want +got):\n%s", diff)
+	}
+
+	if _, err := p.NetSockstat6(); !os.IsNotExist(err) {
+		t.Fatalf("expected sockstat6 to be missing, got: %v", err)
+	}
+}
+
 func Test_readSockstatIsNotExist(t *testing.T) {
 	// On a machine with IPv6 disabled for example, we want to ensure that
 	// readSockstat returns an error that is compatible with os.IsNotExist.
@@ -32,6 +32,7 @@ import (
 // * Linux 5.14 https://elixir.bootlin.com/linux/v5.14/source/net/core/net-procfs.c#L169
 
 // SoftnetStat contains a single row of data from /proc/net/softnet_stat.
+// Columns appended by kernels newer than Linux 5.14 are ignored.
 type SoftnetStat struct {
 	// Number of processed packets.
 	Processed uint32
@@ -40,6 +41,7 @@ type SoftnetStat struct {
 	// Number of times processing packets ran out of quota.
 	TimeSqueezed uint32
 	// Number of collision occur while obtaining device lock while transmitting.
+	// Current kernels no longer maintain this counter and always report zero.
 	CPUCollision uint32
 	// Number of times cpu woken up received_rps.
 	ReceivedRps uint32
@@ -136,6 +138,9 @@ func parseSoftnet(r io.Reader) ([]SoftnetStat, error) {
 		stats = append(stats, softnetStat)
 		cpuIndex++
 	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
 
 	return stats, nil
 }
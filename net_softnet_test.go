@@ -14,6 +14,7 @@
 package procfs
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -88,6 +89,8 @@ func TestNetSoftnet(t *testing.T) {
 }
 
 func TestBadSoftnet(t *testing.T) {
+	orig := softNetProcFile
+	t.Cleanup(func() { softNetProcFile = orig })
 	softNetProcFile = "net/softnet_stat.broken"
 	fs, err := NewFS(procTestFixtures)
 	if err != nil {
@@ -99,3 +102,23 @@ func TestBadSoftnet(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestParseSoftnetWidths(t *testing.T) {
+	const data = `00000010 00000001 00000002 00000000 00000000 00000000 00000000 00000000 00000000
+00000020 00000000 00000003 00000000 00000000 00000000 00000000 00000000 00000000 00000004 00000005
+00000030 00000002 00000001 00000000 00000000 00000000 00000000 00000000 00000000 00000006 00000007 00000008 00000002 00000008 00000000
+`
+	got, err := parseSoftnet(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []SoftnetStat{
+		{Processed: 0x10, Dropped: 1, TimeSqueezed: 2, Index: 0, Width: 9},
+		{Processed: 0x20, TimeSqueezed: 3, ReceivedRps: 4, FlowLimitCount: 5, Index: 1, Width: 11},
+		{Processed: 0x30, Dropped: 2, TimeSqueezed: 1, ReceivedRps: 6, FlowLimitCount: 7, SoftnetBacklogLen: 8, Index: 2, Width: 15},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected softnet stats (-want +got):\n%s", diff)
+	}
+}
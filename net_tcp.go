@@ -13,6 +13,8 @@
 
 package procfs
 
+import "strconv"
+
 type (
 	// NetTCP represents the contents of /proc/net/tcp{,6} file without the header.
 	NetTCP []*netIPSocketLine
@@ -21,8 +23,60 @@ type (
 	// the total number of used sockets. In contrast to NetTCP it does not collect
 	// the parsed lines into a slice.
 	NetTCPSummary NetIPSocketSummary
+
+	// TCPState is the connection state in the st column of /proc/net/tcp{,6}.
+	TCPState uint64
 )
 
+// TCP connection states as defined in include/net/tcp_states.h.
+const (
+	TCPEstablished TCPState = iota + 1
+	TCPSynSent
+	TCPSynRecv
+	TCPFinWait1
+	TCPFinWait2
+	TCPTimeWait
+	TCPClose
+	TCPCloseWait
+	TCPLastAck
+	TCPListen
+	TCPClosing
+	TCPNewSynRecv
+)
+
+var tcpStateNames = map[TCPState]string{
+	TCPEstablished: "ESTABLISHED",
+	TCPSynSent:     "SYN_SENT",
+	TCPSynRecv:     "SYN_RECV",
+	TCPFinWait1:    "FIN_WAIT1",
+	TCPFinWait2:    "FIN_WAIT2",
+	TCPTimeWait:    "TIME_WAIT",
+	TCPClose:       "CLOSE",
+	TCPCloseWait:   "CLOSE_WAIT",
+	TCPLastAck:     "LAST_ACK",
+	TCPListen:      "LISTEN",
+	TCPClosing:     "CLOSING",
+	TCPNewSynRecv:  "NEW_SYN_RECV",
+}
+
+// String returns the state name as printed by ss and netstat, like
+// "ESTABLISHED", or the numeric value for unknown states.
+func (s TCPState) String() string {
+	if name, ok := tcpStateNames[s]; ok {
+		return name
+	}
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// StateCounts returns the number of sockets in each connection state.
+func (n NetTCP) StateCounts() map[TCPState]uint64 {
+	counts := map[TCPState]uint64{}
+	for _, line := range n {
+		counts[TCPState(line.St)]++
+	}
+	return counts
+}
+
 // NetTCP returns the IPv4 kernel/networking statistics for TCP datagrams
 // read from /proc/net/tcp.
 //
@@ -55,6 +109,20 @@ func (fs FS) NetTCP6Summary() (*NetTCPSummary, error) {
 	return newNetTCPSummary(fs.proc.Path("net/tcp6"))
 }
 
+// NetTCP returns the IPv4 kernel/networking statistics for TCP datagrams
+// read from /proc/[pid]/net/tcp, i.e. from the network namespace of the
+// process.
+func (p Proc) NetTCP() (NetTCP, error) {
+	return newNetTCP(p.path("net/tcp"))
+}
+
+// NetTCP6 returns the IPv6 kernel/networking statistics for TCP datagrams
+// read from /proc/[pid]/net/tcp6, i.e. from the network namespace of the
+// process.
+func (p Proc) NetTCP6() (NetTCP, error) {
+	return newNetTCP(p.path("net/tcp6"))
+}
+
 // newNetTCP creates a new NetTCP{,6} from the contents of the given file.
 func newNetTCP(file string) (NetTCP, error) {
 	n, err := newNetIPSocket(file)
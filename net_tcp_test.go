@@ -173,3 +173,51 @@ func Test_newNetTCPSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestNetTCPStateCounts(t *testing.T) {
+	fs, err := NewFS(procTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcp, err := fs.NetTCP()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[TCPState]uint64{TCPListen: 3}
+	if diff := cmp.Diff(want, tcp.StateCounts()); diff != "" {
+		t.Fatalf("unexpected state counts (-want +got):\n%s", diff)
+	}
+
+	for state, name := range map[TCPState]string{
+		TCPEstablished: "ESTABLISHED",
+		TCPListen:      "LISTEN",
+		TCPNewSynRecv:  "NEW_SYN_RECV",
+		TCPState(42):   "42",
+	} {
+		if got := state.String(); got != name {
+			t.Errorf("want state name %q, got %q", name, got)
+		}
+	}
+}
+
+func TestProcNetTCP(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcp, err := p.NetTCP()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[TCPState]uint64{TCPEstablished: 1, TCPListen: 1}
+	if diff := cmp.Diff(want, tcp.StateCounts()); diff != "" {
+		t.Fatalf("unexpected state counts (-want +got):\n%s", diff)
+	}
+	if want, have := (net.IP{10, 88, 0, 2}), tcp[1].LocalAddr; !want.Equal(have) {
+		t.Errorf("want local address %v, have %v", want, have)
+	}
+}
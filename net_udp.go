@@ -47,6 +47,20 @@ func (fs FS) NetUDP6Summary() (*NetUDPSummary, error) {
 	return newNetUDPSummary(fs.proc.Path("net/udp6"))
 }
 
+// NetUDP returns the IPv4 kernel/networking statistics for UDP datagrams
+// read from /proc/[pid]/net/udp, i.e. from the network namespace of the
+// process.
+func (p Proc) NetUDP() (NetUDP, error) {
+	return newNetUDP(p.path("net/udp"))
+}
+
+// NetUDP6 returns the IPv6 kernel/networking statistics for UDP datagrams
+// read from /proc/[pid]/net/udp6, i.e. from the network namespace of the
+// process.
+func (p Proc) NetUDP6() (NetUDP, error) {
+	return newNetUDP(p.path("net/udp6"))
+}
+
 // newNetUDP creates a new NetUDP{,6} from the contents of the given file.
 func newNetUDP(file string) (NetUDP, error) {
 	n, err := newNetIPSocket(file)
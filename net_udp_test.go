@@ -184,3 +184,22 @@ func intToU64(i int) *uint64 {
 	cast := uint64(i)
 	return &cast
 }
+
+func TestProcNetUDP(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	udp, err := p.NetUDP()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, have := 1, len(udp); want != have {
+		t.Fatalf("want %d udp sockets, have %d", want, have)
+	}
+	if want, have := uint64(68), udp[0].LocalPort; want != have {
+		t.Errorf("want local port %d, have %d", want, have)
+	}
+}
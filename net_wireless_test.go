@@ -14,6 +14,7 @@
 package procfs
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -69,3 +70,17 @@ func TestWireless(t *testing.T) {
 		}
 	}
 }
+
+func TestParseWirelessInvalidField(t *testing.T) {
+	const data = `Inter-| sta-|   Quality        |   Discarded packets               | Missed
+ face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon
+ wlan0: 0001    2.   bad.    4.       5      6      7      8      9       10
+`
+	_, err := parseWireless(strings.NewReader(data))
+	if err == nil {
+		t.Fatal("expected error for invalid signal level")
+	}
+	if !strings.Contains(err.Error(), `"bad."`) {
+		t.Errorf("expected error to name the invalid field, got %v", err)
+	}
+}
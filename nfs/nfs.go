@@ -251,6 +251,44 @@ type V4Ops struct {
 	Verify             uint64
 	Write              uint64
 	RelLockOwner       uint64
+	// NFSv4.1 operations, zero on kernels without v4.1 support.
+	BackchannelCtl    uint64
+	BindConnToSession uint64
+	ExchangeID        uint64
+	CreateSession     uint64
+	DestroySession    uint64
+	FreeStateID       uint64
+	GetDirDeleg       uint64
+	GetDeviceInfo     uint64
+	GetDeviceList     uint64
+	LayoutCommit      uint64
+	LayoutGet         uint64
+	LayoutReturn      uint64
+	SecInfoNoName     uint64
+	Sequence          uint64
+	SetSSV            uint64
+	TestStateID       uint64
+	WantDeleg         uint64
+	DestroyClientID   uint64
+	ReclaimComplete   uint64
+	// NFSv4.2 operations, zero on kernels without v4.2 support.
+	Allocate      uint64
+	Copy          uint64
+	CopyNotify    uint64
+	DeAllocate    uint64
+	IOAdvise      uint64
+	LayoutError   uint64
+	LayoutStats   uint64
+	OffloadCancel uint64
+	OffloadStatus uint64
+	ReadPlus      uint64
+	Seek          uint64
+	WriteSame     uint64
+	Clone         uint64
+	GetXattr      uint64
+	SetXattr      uint64
+	ListXattrs    uint64
+	RemoveXattr   uint64
 }
 
 // ClientRPCStats models all stats from /proc/net/rpc/nfs.
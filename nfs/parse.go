@@ -272,10 +272,12 @@ func parseV4Ops(v []uint64) (V4Ops, error) {
 		return V4Ops{}, fmt.Errorf("invalid V4Ops line %q", v)
 	}
 
-	// nfs v2.5.x 39field and >=v2.6.x 40 field;
-	v40 := uint64(0)
-	if values > 39 {
-		v40 = v[40]
+	// nfs v2.5.x 39field and >=v2.6.x 40 field, the NFSv4.1 and v4.2
+	// operations follow. Pad out the values of older kernels.
+	if values < 76 {
+		newValues := make([]uint64, 77)
+		copy(newValues, v)
+		v = newValues
 	}
 
 	stats := V4Ops{
@@ -318,7 +320,43 @@ func parseV4Ops(v []uint64) (V4Ops, error) {
 		SetClientIDConfirm: v[37],
 		Verify:             v[38],
 		Write:              v[39],
-		RelLockOwner:       v40,
+		RelLockOwner:       v[40],
+		BackchannelCtl:     v[41],
+		BindConnToSession:  v[42],
+		ExchangeID:         v[43],
+		CreateSession:      v[44],
+		DestroySession:     v[45],
+		FreeStateID:        v[46],
+		GetDirDeleg:        v[47],
+		GetDeviceInfo:      v[48],
+		GetDeviceList:      v[49],
+		LayoutCommit:       v[50],
+		LayoutGet:          v[51],
+		LayoutReturn:       v[52],
+		SecInfoNoName:      v[53],
+		Sequence:           v[54],
+		SetSSV:             v[55],
+		TestStateID:        v[56],
+		WantDeleg:          v[57],
+		DestroyClientID:    v[58],
+		ReclaimComplete:    v[59],
+		Allocate:           v[60],
+		Copy:               v[61],
+		CopyNotify:         v[62],
+		DeAllocate:         v[63],
+		IOAdvise:           v[64],
+		LayoutError:        v[65],
+		LayoutStats:        v[66],
+		OffloadCancel:      v[67],
+		OffloadStatus:      v[68],
+		ReadPlus:           v[69],
+		Seek:               v[70],
+		WriteSame:          v[71],
+		Clone:              v[72],
+		GetXattr:           v[73],
+		SetXattr:           v[74],
+		ListXattrs:         v[75],
+		RemoveXattr:        v[76],
 	}
 
 	return stats, nil
@@ -636,3 +636,22 @@ wdeleg_getattr 765432`,
 		})
 	}
 }
+
+func TestNFSdServerRPCStatsV4MinorVersionOps(t *testing.T) {
+	content := "proc4ops 76 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 12 0 0 0 0 0 0 0 0 0 0 9876 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 5 0 0 0 2\n"
+
+	stats, err := nfs.ParseServerRPCStats(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := nfs.V4Ops{
+		ExchangeID:  12,
+		Sequence:    9876,
+		Clone:       5,
+		RemoveXattr: 2,
+	}
+	if diff := cmp.Diff(want, stats.V4Ops); diff != "" {
+		t.Fatalf("unexpected NFSv4 operations (-want +got):\n%s", diff)
+	}
+}
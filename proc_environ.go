@@ -35,3 +35,20 @@ func (p Proc) Environ() ([]string, error) {
 
 	return environments, nil
 }
+
+// EnvironKeys returns the names of the environment variables of the process,
+// leaving out their values, which may contain secrets.
+func (p Proc) EnvironKeys() ([]string, error) {
+	environments, err := p.Environ()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(environments))
+	for _, environment := range environments {
+		key, _, _ := strings.Cut(environment, "=")
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
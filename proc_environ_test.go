@@ -45,3 +45,25 @@ func TestProcEnviron(t *testing.T) {
 		}
 	}
 }
+
+func TestProcEnvironKeys(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := p.EnvironKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedKeys := []string{"PATH", "HOSTNAME", "TERM", "GOLANG_VERSION", "GOPATH", "HOME"}
+	if want, have := len(expectedKeys), len(keys); want != have {
+		t.Fatalf("want %d environment keys, have %d", want, have)
+	}
+	for i, key := range keys {
+		if want, have := expectedKeys[i], key; want != have {
+			t.Errorf("%d: want %v, have %v", i, want, have)
+		}
+	}
+}
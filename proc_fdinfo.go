@@ -29,6 +29,9 @@ var (
 	rIno          = regexp.MustCompile(`^ino:\s+(\d+)$`)
 	rInotify      = regexp.MustCompile(`^inotify`)
 	rInotifyParts = regexp.MustCompile(`^inotify\s+wd:([0-9a-f]+)\s+ino:([0-9a-f]+)\s+sdev:([0-9a-f]+)(?:\s+mask:([0-9a-f]+))?`)
+	rEventfd      = regexp.MustCompile(`^eventfd-count:\s+([0-9a-f]+)$`)
+	rEpoll        = regexp.MustCompile(`^tfd:`)
+	rEpollParts   = regexp.MustCompile(`^tfd:\s+(\d+)\s+events:\s+([0-9a-f]+)\s+data:\s+([0-9a-f]+)\s+pos:(\d+)\s+ino:([0-9a-f]+)\s+sdev:([0-9a-f]+)`)
 )
 
 // ProcFDInfo contains represents file descriptor information.
@@ -45,6 +48,10 @@ type ProcFDInfo struct {
 	Ino string
 	// List of inotify lines (structured) in the fdinfo file (kernel 3.8+ only)
 	InotifyInfos []InotifyInfo
+	// Counter of an eventfd in hex, empty for other file types
+	EventfdCount string
+	// List of tfd lines (structured) in the fdinfo file of an epoll instance
+	EpollInfos []EpollInfo
 }
 
 // FDInfo constructor. On kernels older than 3.8, InotifyInfos will always be empty.
@@ -54,8 +61,9 @@ func (p Proc) FDInfo(fd string) (*ProcFDInfo, error) {
 		return nil, err
 	}
 
-	var text, pos, flags, mntid, ino string
+	var text, pos, flags, mntid, ino, eventfd string
 	var inotify []InotifyInfo
+	var epoll []EpollInfo
 
 	scanner := bufio.NewScanner(bytes.NewReader(data))
 	for scanner.Scan() {
@@ -75,8 +83,19 @@ func (p Proc) FDInfo(fd string) (*ProcFDInfo, error) {
 				return nil, err
 			}
 			inotify = append(inotify, *newInotify)
+		case rEventfd.MatchString(text):
+			eventfd = rEventfd.FindStringSubmatch(text)[1]
+		case rEpoll.MatchString(text):
+			newEpoll, err := parseEpollInfo(text)
+			if err != nil {
+				return nil, err
+			}
+			epoll = append(epoll, *newEpoll)
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: fdinfo %q: %w", ErrFileRead, fd, err)
+	}
 
 	i := &ProcFDInfo{
 		FD:           fd,
@@ -85,6 +104,8 @@ func (p Proc) FDInfo(fd string) (*ProcFDInfo, error) {
 		MntID:        mntid,
 		Ino:          ino,
 		InotifyInfos: inotify,
+		EventfdCount: eventfd,
+		EpollInfos:   epoll,
 	}
 
 	return i, nil
@@ -121,6 +142,38 @@ func parseInotifyInfo(line string) (*InotifyInfo, error) {
 	return nil, fmt.Errorf("%w: invalid inode entry: %q", ErrFileParse, line)
 }
 
+// EpollInfo represents a single file descriptor watched by an epoll
+// instance, as listed in its fdinfo file.
+type EpollInfo struct {
+	// Target file descriptor number
+	TFD string
+	// Mask of events being monitored
+	Events string
+	// User data registered with the target
+	Data string
+	// File offset of the target
+	Pos string
+	// Inode number of the target
+	Ino string
+	// Device ID of the target
+	Sdev string
+}
+
+func parseEpollInfo(line string) (*EpollInfo, error) {
+	m := rEpollParts.FindStringSubmatch(line)
+	if m == nil {
+		return nil, fmt.Errorf("%w: invalid epoll entry: %q", ErrFileParse, line)
+	}
+	return &EpollInfo{
+		TFD:    m[1],
+		Events: m[2],
+		Data:   m[3],
+		Pos:    m[4],
+		Ino:    m[5],
+		Sdev:   m[6],
+	}, nil
+}
+
 // ProcFDInfos represents a list of ProcFDInfo structs.
 type ProcFDInfos []ProcFDInfo
 
@@ -137,3 +190,14 @@ func (p ProcFDInfos) InotifyWatchLen() (int, error) {
 
 	return length, nil
 }
+
+// EpollWatchLen returns the total number of file descriptors watched by
+// epoll instances.
+func (p ProcFDInfos) EpollWatchLen() (int, error) {
+	length := 0
+	for _, f := range p {
+		length += len(f.EpollInfos)
+	}
+
+	return length, nil
+}
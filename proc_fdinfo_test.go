@@ -32,3 +32,27 @@ func TestInotifyWatchLen(t *testing.T) {
 		t.Errorf("want length %d, have %d", want, have)
 	}
 }
+
+func TestEpollWatchLen(t *testing.T) {
+	p1, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fdinfos, err := p1.FileDescriptorsInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := fdinfos.EpollWatchLen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, l; want != have {
+		t.Errorf("want length %d, have %d", want, have)
+	}
+}
+
+func TestParseEpollInfoInvalid(t *testing.T) {
+	if _, err := parseEpollInfo("tfd: 5 events: 19"); err == nil {
+		t.Error("expected error for truncated tfd line")
+	}
+}
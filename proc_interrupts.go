@@ -40,6 +40,16 @@ type Interrupt struct {
 // - https://raspberrypi.stackexchange.com/questions/105802/explanation-of-proc-interrupts-output
 type Interrupts map[string]Interrupt
 
+// Interrupts returns the system-wide interrupt counters read from
+// /proc/interrupts .
+func (fs FS) Interrupts() (Interrupts, error) {
+	data, err := util.ReadFileNoStat(fs.proc.Path("interrupts"))
+	if err != nil {
+		return nil, err
+	}
+	return parseInterrupts(bytes.NewReader(data))
+}
+
 // Interrupts creates a new instance from a given Proc instance.
 func (p Proc) Interrupts() (Interrupts, error) {
 	data, err := util.ReadFileNoStat(p.fs.proc.Path("interrupts"))
@@ -96,3 +106,25 @@ func parseInterrupts(r io.Reader) (Interrupts, error) {
 
 	return interrupts, scanner.Err()
 }
+
+// Counts returns the per-CPU interrupt counts parsed from Values.
+func (i Interrupt) Counts() ([]uint64, error) {
+	counts, err := util.ParseUint64s(i.Values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: couldn't parse interrupt counts %q: %w", ErrFileParse, i.Values, err)
+	}
+	return counts, nil
+}
+
+// Total returns the sum of the per-CPU interrupt counts.
+func (i Interrupt) Total() (uint64, error) {
+	counts, err := i.Counts()
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, c := range counts {
+		total += c
+	}
+	return total, nil
+}
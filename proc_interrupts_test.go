@@ -93,3 +93,35 @@ func TestProcInterrupts(t *testing.T) {
 		})
 	}
 }
+
+func TestFSInterrupts(t *testing.T) {
+	interrupts, err := getProcFixtures(t).Interrupts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timer, ok := interrupts["0"]
+	if !ok {
+		t.Fatal("IRQ 0 not found")
+	}
+
+	counts, err := timer.Counts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]uint64{49, 0, 0, 0}, counts); diff != "" {
+		t.Errorf("unexpected counts (-want +got):\n%s", diff)
+	}
+
+	total, err := interrupts["LOC"].Total()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(10196 + 7429 + 8542 + 8229); total != want {
+		t.Errorf("want LOC total %d, got %d", want, total)
+	}
+
+	if _, err := (Interrupt{Values: []string{"x"}}).Counts(); err == nil {
+		t.Error("expected error for malformed count")
+	}
+}
@@ -22,7 +22,7 @@ import (
 	"strings"
 )
 
-// ProcLimits represents the soft limits for each of the process's resource
+// ProcLimits represents the soft or hard limits for each of the process's resource
 // limits. For more information see getrlimit(2):
 // http://man7.org/linux/man-pages/man2/getrlimit.2.html.
 type ProcLimits struct {
@@ -87,6 +87,18 @@ func (p Proc) NewLimits() (ProcLimits, error) {
 
 // Limits returns the current soft limits of the process.
 func (p Proc) Limits() (ProcLimits, error) {
+	return p.limits(2)
+}
+
+// HardLimits returns the current hard limits of the process, the ceilings up
+// to which an unprivileged process may raise its soft limits.
+func (p Proc) HardLimits() (ProcLimits, error) {
+	return p.limits(3)
+}
+
+// limits parses /proc/[pid]/limits, reading values from the given submatch of
+// limitsMatch: 2 for the soft and 3 for the hard limits.
+func (p Proc) limits(column int) (ProcLimits, error) {
 	f, err := os.Open(p.path("limits"))
 	if err != nil {
 		return ProcLimits{}, err
@@ -109,37 +121,37 @@ func (p Proc) Limits() (ProcLimits, error) {
 
 		switch strings.TrimSpace(fields[1]) {
 		case "Max cpu time":
-			l.CPUTime, err = parseUint(fields[2])
+			l.CPUTime, err = parseUint(fields[column])
 		case "Max file size":
-			l.FileSize, err = parseUint(fields[2])
+			l.FileSize, err = parseUint(fields[column])
 		case "Max data size":
-			l.DataSize, err = parseUint(fields[2])
+			l.DataSize, err = parseUint(fields[column])
 		case "Max stack size":
-			l.StackSize, err = parseUint(fields[2])
+			l.StackSize, err = parseUint(fields[column])
 		case "Max core file size":
-			l.CoreFileSize, err = parseUint(fields[2])
+			l.CoreFileSize, err = parseUint(fields[column])
 		case "Max resident set":
-			l.ResidentSet, err = parseUint(fields[2])
+			l.ResidentSet, err = parseUint(fields[column])
 		case "Max processes":
-			l.Processes, err = parseUint(fields[2])
+			l.Processes, err = parseUint(fields[column])
 		case "Max open files":
-			l.OpenFiles, err = parseUint(fields[2])
+			l.OpenFiles, err = parseUint(fields[column])
 		case "Max locked memory":
-			l.LockedMemory, err = parseUint(fields[2])
+			l.LockedMemory, err = parseUint(fields[column])
 		case "Max address space":
-			l.AddressSpace, err = parseUint(fields[2])
+			l.AddressSpace, err = parseUint(fields[column])
 		case "Max file locks":
-			l.FileLocks, err = parseUint(fields[2])
+			l.FileLocks, err = parseUint(fields[column])
 		case "Max pending signals":
-			l.PendingSignals, err = parseUint(fields[2])
+			l.PendingSignals, err = parseUint(fields[column])
 		case "Max msgqueue size":
-			l.MsqqueueSize, err = parseUint(fields[2])
+			l.MsqqueueSize, err = parseUint(fields[column])
 		case "Max nice priority":
-			l.NicePriority, err = parseUint(fields[2])
+			l.NicePriority, err = parseUint(fields[column])
 		case "Max realtime priority":
-			l.RealtimePriority, err = parseUint(fields[2])
+			l.RealtimePriority, err = parseUint(fields[column])
 		case "Max realtime timeout":
-			l.RealtimeTimeout, err = parseUint(fields[2])
+			l.RealtimeTimeout, err = parseUint(fields[column])
 		}
 		if err != nil {
 			return ProcLimits{}, err
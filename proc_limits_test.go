@@ -53,3 +53,32 @@ func TestLimits(t *testing.T) {
 		}
 	}
 }
+
+func TestHardLimits(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := p.HardLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name string
+		want uint64
+		have uint64
+	}{
+		{name: "stack size", want: 18446744073709551615, have: l.StackSize},
+		{name: "core file size", want: 18446744073709551615, have: l.CoreFileSize},
+		{name: "processes", want: 62898, have: l.Processes},
+		{name: "open files", want: 4096, have: l.OpenFiles},
+		{name: "address space", want: 18446744073709551615, have: l.AddressSpace},
+		{name: "msgqueue size", want: 819200, have: l.MsqqueueSize},
+	} {
+		if test.want != test.have {
+			t.Errorf("want %s %d, have %d", test.name, test.want, test.have)
+		}
+	}
+}
@@ -204,6 +204,9 @@ func (p Proc) ProcMaps() ([]*ProcMap, error) {
 
 		maps = append(maps, m)
 	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
 
 	return maps, nil
 }
@@ -169,6 +169,18 @@ type IpExt struct { // nolint:revive
 	ReasmOverlaps   *float64
 }
 
+// NetNetstat returns the extended TCP and IP counters of the network
+// namespace of the calling process, read from /proc/net/netstat . PID is
+// left unset.
+func (fs FS) NetNetstat() (ProcNetstat, error) {
+	filename := fs.proc.Path("net/netstat")
+	data, err := util.ReadFileNoStat(filename)
+	if err != nil {
+		return ProcNetstat{}, err
+	}
+	return parseProcNetstat(bytes.NewReader(data), filename)
+}
+
 func (p Proc) Netstat() (ProcNetstat, error) {
 	filename := p.path("net/netstat")
 	data, err := util.ReadFileNoStat(filename)
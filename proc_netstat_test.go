@@ -48,3 +48,25 @@ func TestProcNetstat(t *testing.T) {
 		}
 	}
 }
+
+func TestNetNetstat(t *testing.T) {
+	netstat, err := getProcFixtures(t).NetNetstat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name string
+		want float64
+		have float64
+	}{
+		{name: "pid", want: 0, have: float64(netstat.PID)},
+		{name: "TcpExt:TW", want: 83, have: *netstat.TW},
+		{name: "TcpExt:DelayedACKs", want: 287, have: *netstat.DelayedACKs},
+		{name: "TcpExt:ListenOverflows", want: 0, have: *netstat.ListenOverflows},
+	} {
+		if test.want != test.have {
+			t.Errorf("want %s %f, have %f", test.name, test.want, test.have)
+		}
+	}
+}
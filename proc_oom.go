@@ -0,0 +1,40 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// OOMScore returns the badness score the OOM killer currently assigns to the
+// process, read from `/proc/<pid>/oom_score`. When memory runs out, the
+// process with the highest score is killed first.
+func (p Proc) OOMScore() (int, error) {
+	score, err := util.ReadIntFromFile(p.path("oom_score"))
+	if err != nil {
+		return 0, err
+	}
+	return int(score), nil
+}
+
+// OOMScoreAdj returns the adjustment added to the OOM score of the process,
+// read from `/proc/<pid>/oom_score_adj`. It ranges from -1000, which disables
+// OOM killing of the process, to 1000.
+func (p Proc) OOMScoreAdj() (int, error) {
+	adj, err := util.ReadIntFromFile(p.path("oom_score_adj"))
+	if err != nil {
+		return 0, err
+	}
+	return int(adj), nil
+}
@@ -0,0 +1,39 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import "testing"
+
+func TestProcOOMScore(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	score, err := p.OOMScore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 667, score; want != have {
+		t.Errorf("want oom_score %d, have %d", want, have)
+	}
+
+	adj, err := p.OOMScoreAdj()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := -500, adj; want != have {
+		t.Errorf("want oom_score_adj %d, have %d", want, have)
+	}
+}
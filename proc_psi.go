@@ -57,7 +57,8 @@ type PSIStats struct {
 
 // PSIStatsForResource reads pressure stall information for the specified
 // resource from /proc/pressure/<resource>. At time of writing this can be
-// either "cpu", "memory" or "io".
+// either "cpu", "memory", "io" or "irq". The "irq" resource only reports
+// "full" pressure and requires CONFIG_IRQ_TIME_ACCOUNTING.
 func (fs FS) PSIStatsForResource(resource string) (PSIStats, error) {
 	data, err := util.ReadFileNoStat(fs.proc.Path(fmt.Sprintf("%s/%s", "pressure", resource)))
 	if err != nil {
@@ -97,6 +98,9 @@ func parsePSIStats(r io.Reader) (PSIStats, error) {
 			continue
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return PSIStats{}, err
+	}
 
 	return psiStats, nil
 }
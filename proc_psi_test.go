@@ -64,6 +64,26 @@ func TestPSIStats(t *testing.T) {
 		}
 	})
 
+	t.Run("irq", func(t *testing.T) {
+		stats, err := getProcFixtures(t).PSIStatsForResource("irq")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if stats.Some != nil {
+			t.Fatal("irq resource cannot have 'some' stats")
+		}
+
+		if stats.Full == nil {
+			t.Fatal("irq resource should not have nil 'full' stats")
+		}
+
+		want := PSILine{Avg10: 0.02, Avg60: 0.01, Avg300: 0, Total: 4861}
+		if *stats.Full != want {
+			t.Errorf("got: %+v, want: %+v", *stats.Full, want)
+		}
+	})
+
 	res := []string{"memory", "io"}
 
 	for _, resource := range res {
@@ -31,11 +31,22 @@ var (
 	procSMapsHeaderLine = regexp.MustCompile(`^[a-f0-9].*$`)
 )
 
+// ProcSMapsRollup contains the memory usage of a process summed over all of
+// its mappings, in bytes.
 type ProcSMapsRollup struct {
 	// Amount of the mapping that is currently resident in RAM.
 	Rss uint64
 	// Process's proportional share of this mapping.
 	Pss uint64
+	// Proportional share of anonymous pages. Only reported in smaps_rollup
+	// (kernel >= 5.7).
+	PssAnon uint64
+	// Proportional share of file backed pages. Only reported in smaps_rollup
+	// (kernel >= 5.7).
+	PssFile uint64
+	// Proportional share of shmem pages. Only reported in smaps_rollup
+	// (kernel >= 5.7).
+	PssShmem uint64
 	// Size in bytes of clean shared pages.
 	SharedClean uint64
 	// Size in bytes of dirty shared pages.
@@ -108,6 +119,9 @@ func (p Proc) procSMapsRollupManual() (ProcSMapsRollup, error) {
 			return ProcSMapsRollup{}, err
 		}
 	}
+	if err := scan.Err(); err != nil {
+		return ProcSMapsRollup{}, err
+	}
 
 	return smaps, nil
 }
@@ -115,7 +129,7 @@ func (p Proc) procSMapsRollupManual() (ProcSMapsRollup, error) {
 func (s *ProcSMapsRollup) parseLine(line string) error {
 	kv := strings.SplitN(line, ":", 2)
 	if len(kv) != 2 {
-		return errors.New("invalid net/dev line, missing colon")
+		return errors.New("invalid smaps line, missing colon")
 	}
 
 	k := kv[0]
@@ -143,6 +157,12 @@ func (s *ProcSMapsRollup) addValue(k string, vUintBytes uint64) {
 		s.Rss += vUintBytes
 	case "Pss":
 		s.Pss += vUintBytes
+	case "Pss_Anon":
+		s.PssAnon += vUintBytes
+	case "Pss_File":
+		s.PssFile += vUintBytes
+	case "Pss_Shmem":
+		s.PssShmem += vUintBytes
 	case "Shared_Clean":
 		s.SharedClean += vUintBytes
 	case "Shared_Dirty":
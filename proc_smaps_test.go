@@ -65,6 +65,16 @@ func TestProcSmapsRollup(t *testing.T) {
 		},
 	}
 
+	if want, have := uint64(20756*1024), s1.PssAnon; want != have {
+		t.Errorf("want PssAnon %d, have %d", want, have)
+	}
+	if want, have := uint64(9188*1024), s1.PssFile; want != have {
+		t.Errorf("want PssFile %d, have %d", want, have)
+	}
+	if want, have := s1.Pss, s1.PssAnon+s1.PssFile+s1.PssShmem; want != have {
+		t.Errorf("want Pss breakdown to sum to %d, have %d", want, have)
+	}
+
 	for _, c := range cases {
 		for _, test := range []struct {
 			name string
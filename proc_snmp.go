@@ -133,6 +133,17 @@ type UdpLite struct { // nolint:revive
 	IgnoredMulti *float64
 }
 
+// NetSnmp returns the protocol counters of the network namespace of the
+// calling process, read from /proc/net/snmp . PID is left unset.
+func (fs FS) NetSnmp() (ProcSnmp, error) {
+	filename := fs.proc.Path("net/snmp")
+	data, err := util.ReadFileNoStat(filename)
+	if err != nil {
+		return ProcSnmp{}, err
+	}
+	return parseSnmp(bytes.NewReader(data), filename)
+}
+
 func (p Proc) Snmp() (ProcSnmp, error) {
 	filename := p.path("net/snmp")
 	data, err := util.ReadFileNoStat(filename)
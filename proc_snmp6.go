@@ -138,6 +138,21 @@ type UdpLite6 struct { // nolint:revive
 	InCsumErrors *float64
 }
 
+// NetSnmp6 returns the IPv6 protocol counters of the network namespace of
+// the calling process, read from /proc/net/snmp6 . On systems with IPv6
+// disabled the file is missing and empty counters are returned. PID is left
+// unset.
+func (fs FS) NetSnmp6() (ProcSnmp6, error) {
+	data, err := util.ReadFileNoStat(fs.proc.Path("net/snmp6"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ProcSnmp6{}, nil
+		}
+		return ProcSnmp6{}, err
+	}
+	return parseSNMP6Stats(bytes.NewReader(data))
+}
+
 func (p Proc) Snmp6() (ProcSnmp6, error) {
 	filename := p.path("net/snmp6")
 	data, err := util.ReadFileNoStat(filename)
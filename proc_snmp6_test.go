@@ -46,3 +46,24 @@ func TestProcSnmp6(t *testing.T) {
 	}
 
 }
+
+func TestNetSnmp6(t *testing.T) {
+	snmp6, err := getProcFixtures(t).NetSnmp6()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name string
+		want float64
+		have float64
+	}{
+		{name: "pid", want: 0, have: float64(snmp6.PID)},
+		{name: "Ip6:InReceives", want: 92166, have: *snmp6.Ip6.InReceives},
+		{name: "Udp6:InDatagrams", want: 2016, have: *snmp6.Udp6.InDatagrams},
+	} {
+		if test.want != test.have {
+			t.Errorf("want %s %f, have %f", test.name, test.want, test.have)
+		}
+	}
+}
@@ -50,3 +50,25 @@ func TestProcSnmp(t *testing.T) {
 	}
 
 }
+
+func TestNetSnmp(t *testing.T) {
+	snmp, err := getProcFixtures(t).NetSnmp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name string
+		want float64
+		have float64
+	}{
+		{name: "pid", want: 0, have: float64(snmp.PID)},
+		{name: "IP:Forwarding", want: 2, have: *snmp.Forwarding},
+		{name: "TCP:RetransSegs", want: 348, have: *snmp.RetransSegs},
+		{name: "TCP:InErrs", want: 98, have: *snmp.Tcp.InErrs},
+	} {
+		if test.want != test.have {
+			t.Errorf("want %s %f, have %f", test.name, test.want, test.have)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// ProcStackFrame is a single frame of the kernel stack of a process, read
+// from a line of `/proc/<pid>/stack` like
+// "[<0>] ep_poll+0x2b8/0x3a0 [mymodule]".
+type ProcStackFrame struct {
+	// Return address of the frame. Kernels hide the address from /proc
+	// (reporting 0) unless kptr_restrict allows it.
+	Address uint64
+	// Name of the function the frame belongs to, empty if the kernel could
+	// not resolve the address. The raw address printed instead is then
+	// stored in Address.
+	Symbol string
+	// Offset of the return address into the function.
+	Offset uint64
+	// Size of the function.
+	Size uint64
+	// Module providing the function, empty for the core kernel.
+	Module string
+}
+
+// Stack returns the kernel stack of the process, innermost frame first.
+// Reading `/proc/<pid>/stack` requires CAP_SYS_ADMIN.
+func (p Proc) Stack() ([]ProcStackFrame, error) {
+	data, err := util.ReadFileNoStat(p.path("stack"))
+	if err != nil {
+		return nil, err
+	}
+	return parseProcStack(data)
+}
+
+func parseProcStack(data []byte) ([]ProcStackFrame, error) {
+	var frames []ProcStackFrame
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := s.Text()
+		if line == "" {
+			continue
+		}
+		frame, err := parseProcStackFrame(line)
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, *frame)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("%w: stack: %w", ErrFileRead, err)
+	}
+
+	return frames, nil
+}
+
+func parseProcStackFrame(line string) (*ProcStackFrame, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("%w: malformed stack line %q", ErrFileParse, line)
+	}
+
+	addr, ok := strings.CutPrefix(fields[0], "[<")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed stack address in %q", ErrFileParse, line)
+	}
+	addr, ok = strings.CutSuffix(addr, ">]")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed stack address in %q", ErrFileParse, line)
+	}
+
+	var (
+		frame ProcStackFrame
+		err   error
+	)
+	frame.Address, err = strconv.ParseUint(addr, 16, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: stack address in %q: %w", ErrFileParse, line, err)
+	}
+
+	if len(fields) > 2 {
+		// The module may be followed by its build ID, like "[mymodule abc123]".
+		module := strings.Join(fields[2:], " ")
+		if !strings.HasPrefix(module, "[") || !strings.HasSuffix(module, "]") {
+			return nil, fmt.Errorf("%w: malformed stack module in %q", ErrFileParse, line)
+		}
+		if f := strings.Fields(strings.Trim(module, "[]")); len(f) > 0 {
+			frame.Module = f[0]
+		}
+	}
+
+	// Addresses that could not be resolved are printed as a raw hex value.
+	if raw, ok := strings.CutPrefix(fields[1], "0x"); ok && !strings.Contains(raw, "+") {
+		frame.Address, err = strconv.ParseUint(raw, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: stack address in %q: %w", ErrFileParse, line, err)
+		}
+		return &frame, nil
+	}
+
+	// The symbol is printed as <name>+<offset>/<size>, both in hex.
+	symbol, location, ok := strings.Cut(fields[1], "+")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed stack symbol in %q", ErrFileParse, line)
+	}
+	frame.Symbol = symbol
+	offset, size, ok := strings.Cut(location, "/")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed stack symbol in %q", ErrFileParse, line)
+	}
+	frame.Offset, err = strconv.ParseUint(offset, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: stack symbol offset in %q: %w", ErrFileParse, line, err)
+	}
+	frame.Size, err = strconv.ParseUint(size, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: stack symbol size in %q: %w", ErrFileParse, line, err)
+	}
+
+	return &frame, nil
+}
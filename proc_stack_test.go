@@ -0,0 +1,114 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProcStack(t *testing.T) {
+	p, err := getProcFixtures(t).Proc(26231)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames, err := p.Stack()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ProcStackFrame{
+		{Symbol: "do_select", Offset: 0x5b1, Size: 0x8b0},
+		{Symbol: "core_sys_select", Offset: 0x1de, Size: 0x350},
+		{Symbol: "fuse_dev_do_read", Offset: 0x8f3, Size: 0x9a0, Module: "fuse"},
+		{Symbol: "entry_SYSCALL_64_after_hwframe", Offset: 0x76, Size: 0x7e},
+	}
+	if diff := cmp.Diff(want, frames); diff != "" {
+		t.Fatalf("unexpected stack (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseProcStack(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []ProcStackFrame
+		wantErr bool
+	}{
+		{
+			name: "address",
+			data: "[<ffffffff8122f4b5>] ep_poll+0x2b8/0x3a0\n",
+			want: []ProcStackFrame{{Address: 0xffffffff8122f4b5, Symbol: "ep_poll", Offset: 0x2b8, Size: 0x3a0}},
+		},
+		{
+			name: "module with build ID",
+			data: "[<0>] nfs_wait_bit_killable+0x20/0x90 [nfs 5a2b1c9e0f]\n",
+			want: []ProcStackFrame{{Symbol: "nfs_wait_bit_killable", Offset: 0x20, Size: 0x90, Module: "nfs"}},
+		},
+		{
+			name: "unresolved address",
+			data: "[<0>] 0xffffffffc0a1b2c3\n",
+			want: []ProcStackFrame{{Address: 0xffffffffc0a1b2c3}},
+		},
+		{
+			name: "unresolved address in module",
+			data: "[<0>] 0xffffffffc0a1b2c3 [mymodule]\n",
+			want: []ProcStackFrame{{Address: 0xffffffffc0a1b2c3, Module: "mymodule"}},
+		},
+		{
+			name: "empty",
+			data: "",
+		},
+		{
+			name:    "missing address brackets",
+			data:    "0 ep_poll+0x2b8/0x3a0\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing size",
+			data:    "[<0>] ep_poll+0x2b8\n",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated module",
+			data:    "[<0>] ep_poll+0x2b8/0x3a0 [mymodule\n",
+			wantErr: true,
+		},
+		{
+			name:    "bad offset",
+			data:    "[<0>] ep_poll+zz/0x3a0\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseProcStack([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, but none occurred")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("unexpected stack (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,9 +30,17 @@ type ProcStatus struct {
 	PID int
 	// The process name.
 	Name string
+	// The process state, e.g. "S (sleeping)".
+	State string
 
 	// Thread group ID.
 	TGID int
+	// The PID of the parent process.
+	PPID int
+	// The PID of the process tracing this process, 0 if not traced.
+	TracerPID int
+	// Number of threads in the thread group.
+	Threads uint64
 	// List of Pid namespace.
 	NSpids []uint64
 
@@ -141,6 +149,14 @@ func (s *ProcStatus) fillStatus(k string, vString string, vUint uint64, vUintByt
 		s.TGID = int(vUint)
 	case "Name":
 		s.Name = vString
+	case "State":
+		s.State = vString
+	case "PPid":
+		s.PPID = int(vUint)
+	case "TracerPid":
+		s.TracerPID = int(vUint)
+	case "Threads":
+		s.Threads = vUint
 	case "Uid":
 		var err error
 		for i, v := range strings.Split(vString, "\t") {
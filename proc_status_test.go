@@ -37,6 +37,9 @@ func TestProcStatus(t *testing.T) {
 	}{
 		{name: "Pid", want: 26231, have: s.PID},
 		{name: "Tgid", want: 26231, have: s.TGID},
+		{name: "PPid", want: 1, have: s.PPID},
+		{name: "TracerPid", want: 0, have: s.TracerPID},
+		{name: "Threads", want: 1, have: int(s.Threads)},
 		{name: "NSpid", want: 1, have: int(s.NSpids[0])},
 		{name: "VmPeak", want: 58472 * 1024, have: int(s.VmPeak)},
 		{name: "VmSize", want: 58440 * 1024, have: int(s.VmSize)},
@@ -77,6 +80,9 @@ func TestProcStatusName(t *testing.T) {
 	if want, have := "prometheus", s.Name; want != have {
 		t.Errorf("want name %s, have %s", want, have)
 	}
+	if want, have := "S (sleeping)", s.State; want != have {
+		t.Errorf("want state %s, have %s", want, have)
+	}
 }
 
 func TestProcStatusNameTrim(t *testing.T) {
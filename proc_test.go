@@ -206,7 +206,7 @@ func TestFileDescriptors(t *testing.T) {
 		t.Fatal(err)
 	}
 	sort.Sort(byUintptr(fds))
-	if want := []uintptr{0, 1, 2, 3, 10}; !reflect.DeepEqual(want, fds) {
+	if want := []uintptr{0, 1, 2, 3, 10, 11, 12}; !reflect.DeepEqual(want, fds) {
 		t.Errorf("want fds %v, have %v", want, fds)
 	}
 }
@@ -227,6 +227,8 @@ func TestFileDescriptorTargets(t *testing.T) {
 		"../../symlinktargets/ghi",
 		"../../symlinktargets/uvw",
 		"../../symlinktargets/xyz",
+		"anon_inode:[eventfd]",
+		"anon_inode:[eventpoll]",
 	}
 	if diff := cmp.Diff(want, fds); diff != "" {
 		t.Fatalf("unexpected fds (-want +got):\n%s", diff)
@@ -242,7 +244,7 @@ func TestFileDescriptorsLen(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if want, have := 5, l; want != have {
+	if want, have := 7, l; want != have {
 		t.Errorf("want fds %d, have %d", want, have)
 	}
 }
@@ -263,8 +265,13 @@ func TestFileDescriptorsInfo(t *testing.T) {
 			{WD: "2", Ino: "1300016", Sdev: "fd00002", Mask: "fce"},
 			{WD: "1", Ino: "2e0001", Sdev: "fd00000", Mask: "fce"},
 		}},
-		ProcFDInfo{FD: "1", Pos: "0", Flags: "02004002", MntID: "13", InotifyInfos: nil},
+		ProcFDInfo{FD: "1", Pos: "0", Flags: "02004002", MntID: "13", InotifyInfos: nil, EventfdCount: "0"},
 		ProcFDInfo{FD: "10", Pos: "0", Flags: "02004002", MntID: "9", InotifyInfos: nil},
+		ProcFDInfo{FD: "11", Pos: "0", Flags: "02000002", MntID: "9", EpollInfos: []EpollInfo{
+			{TFD: "5", Events: "19", Data: "5", Pos: "0", Ino: "61af", Sdev: "7"},
+			{TFD: "7", Events: "19", Data: "7fa1c0001240", Pos: "0", Ino: "1f58", Sdev: "7"},
+		}},
+		ProcFDInfo{FD: "12", Pos: "0", Flags: "02004002", MntID: "9", EventfdCount: "2a"},
 		ProcFDInfo{FD: "2", Pos: "0", Flags: "02004002", MntID: "9", InotifyInfos: nil},
 		ProcFDInfo{FD: "3", Pos: "0", Flags: "02004002", MntID: "9", InotifyInfos: nil},
 	}
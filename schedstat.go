@@ -16,9 +16,13 @@ package procfs
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
 )
 
 var (
@@ -37,7 +41,9 @@ var (
 // introduction of CFS. A fix to the documentation is pending. See
 // https://lore.kernel.org/patchwork/project/lkml/list/?series=403473
 type Schedstat struct {
-	CPUs []*SchedstatCPU
+	// Version of the file format.
+	Version int
+	CPUs    []*SchedstatCPU
 }
 
 // SchedstatCPU contains the values from one "cpu<N>" line.
@@ -47,8 +53,69 @@ type SchedstatCPU struct {
 	RunningNanoseconds uint64
 	WaitingNanoseconds uint64
 	RunTimeslices      uint64
+
+	// Domains holds the scheduling domains of the CPU, from the "domain<N>"
+	// lines following the "cpu<N>" line. It is only filled in for format
+	// versions 15 and 16.
+	Domains []*SchedstatDomain
+}
+
+// SchedstatDomain contains the load balancing counters of one scheduling
+// domain of a CPU.
+type SchedstatDomain struct {
+	// Name of the domain, like "domain0".
+	Name string
+	// Mask of the CPUs spanned by the domain, in hex.
+	CPUMask string
+
+	// Load balancing runs while the CPU was idle.
+	Idle SchedstatLoadBalance
+	// Load balancing runs while the CPU was busy.
+	Busy SchedstatLoadBalance
+	// Load balancing runs when the CPU was just becoming idle.
+	NewlyIdle SchedstatLoadBalance
+
+	// Number of times active load balancing was tried, failed and moved a
+	// task.
+	ActiveLoadBalanceCount  uint64
+	ActiveLoadBalanceFailed uint64
+	ActiveLoadBalancePushed uint64
+
+	// Number of times a task woken up on this CPU last ran on a different
+	// CPU of the domain.
+	WakeRemote uint64
+	// Number of wake ups that moved a task to the waking CPU because it was
+	// cache-cold there.
+	WakeMoveAffine uint64
+	// Number of wake ups that moved a task for passive load balancing.
+	WakeMoveBalance uint64
+}
+
+// SchedstatLoadBalance contains the counters of load balancing runs in a
+// scheduling domain for one idle state of the CPU.
+type SchedstatLoadBalance struct {
+	// Number of times load balancing was called.
+	Count uint64
+	// Number of times the domain was found to be balanced already.
+	Balanced uint64
+	// Number of times moving a task failed.
+	Failed uint64
+	// Sum of the imbalances found.
+	Imbalance uint64
+	// Number of tasks pulled to this CPU.
+	Gained uint64
+	// Number of pulled tasks that were cache-hot on their old CPU.
+	HotGained uint64
+	// Number of times no busier queue was found in the busiest group.
+	NoBusyQueue uint64
+	// Number of times no busier group was found.
+	NoBusyGroup uint64
 }
 
+// schedstatDomainFields is the number of counters following the CPU mask of
+// a "domain<N>" line in format versions 15 and 16.
+const schedstatDomainFields = 36
+
 // ProcSchedstat contains the values from `/proc/<pid>/schedstat`.
 type ProcSchedstat struct {
 	RunningNanoseconds uint64
@@ -67,10 +134,32 @@ func (fs FS) Schedstat() (*Schedstat, error) {
 	stats := &Schedstat{}
 	scanner := bufio.NewScanner(file)
 
+	var cpu *SchedstatCPU
 	for scanner.Scan() {
-		match := cpuLineRE.FindStringSubmatch(scanner.Text())
+		line := scanner.Text()
+		if v, ok := strings.CutPrefix(line, "version "); ok {
+			stats.Version, err = strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: schedstat version %q: %w", ErrFileParse, v, err)
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "domain") {
+			if cpu == nil || (stats.Version != 15 && stats.Version != 16) {
+				continue
+			}
+			domain, err := parseSchedstatDomain(line, stats.Version)
+			if err != nil {
+				return nil, err
+			}
+			cpu.Domains = append(cpu.Domains, domain)
+			continue
+		}
+
+		cpu = nil
+		match := cpuLineRE.FindStringSubmatch(line)
 		if match != nil {
-			cpu := &SchedstatCPU{}
+			cpu = &SchedstatCPU{}
 			cpu.CPUNum = match[1]
 
 			cpu.RunningNanoseconds, err = strconv.ParseUint(match[8], 10, 64)
@@ -91,10 +180,63 @@ func (fs FS) Schedstat() (*Schedstat, error) {
 			stats.CPUs = append(stats.CPUs, cpu)
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: schedstat: %w", ErrFileRead, err)
+	}
 
 	return stats, nil
 }
 
+// parseSchedstatDomain parses a "domain<N> <cpumask> <counters>" line. Version
+// 16 moved the busy counters in front of the idle ones.
+func parseSchedstatDomain(line string, version int) (*SchedstatDomain, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 2+schedstatDomainFields {
+		return nil, fmt.Errorf("%w: schedstat domain line has %d fields, want %d: %q", ErrFileParse, len(fields), 2+schedstatDomainFields, line)
+	}
+
+	v, err := util.ParseUint64s(fields[2:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: schedstat domain line %q: %w", ErrFileParse, line, err)
+	}
+
+	lb := func(v []uint64) SchedstatLoadBalance {
+		return SchedstatLoadBalance{
+			Count:       v[0],
+			Balanced:    v[1],
+			Failed:      v[2],
+			Imbalance:   v[3],
+			Gained:      v[4],
+			HotGained:   v[5],
+			NoBusyQueue: v[6],
+			NoBusyGroup: v[7],
+		}
+	}
+
+	domain := &SchedstatDomain{
+		Name:      fields[0],
+		CPUMask:   fields[1],
+		Idle:      lb(v[0:8]),
+		Busy:      lb(v[8:16]),
+		NewlyIdle: lb(v[16:24]),
+
+		ActiveLoadBalanceCount:  v[24],
+		ActiveLoadBalanceFailed: v[25],
+		ActiveLoadBalancePushed: v[26],
+
+		// v[27:33] are the sched_balance_exec and sched_balance_fork
+		// counters, which the kernel no longer updates.
+		WakeRemote:      v[33],
+		WakeMoveAffine:  v[34],
+		WakeMoveBalance: v[35],
+	}
+	if version >= 16 {
+		domain.Idle, domain.Busy = lb(v[8:16]), lb(v[0:8])
+	}
+
+	return domain, nil
+}
+
 func parseProcSchedstat(contents string) (ProcSchedstat, error) {
 	var (
 		stats ProcSchedstat
@@ -15,6 +15,8 @@ package procfs
 
 import (
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestSchedstat(t *testing.T) {
@@ -49,6 +51,59 @@ func TestSchedstat(t *testing.T) {
 	if want, have := uint64(4767485306), cpu.RunTimeslices; want != have {
 		t.Errorf("want RunTimeslices %v, have %v", want, have)
 	}
+
+	if want, have := 15, stats.Version; want != have {
+		t.Errorf("want Version %v, have %v", want, have)
+	}
+
+	want := []*SchedstatDomain{{
+		Name:    "domain0",
+		CPUMask: "00000000,00000003",
+		Idle: SchedstatLoadBalance{
+			Count: 212499247, Balanced: 210112015, Failed: 1861015, Imbalance: 1860405436,
+			Gained: 536440, HotGained: 369895, NoBusyQueue: 32599, NoBusyGroup: 210079416,
+		},
+		Busy: SchedstatLoadBalance{
+			Count: 25368550, Balanced: 24241256, Failed: 384652, Imbalance: 927363878,
+			Gained: 807233, HotGained: 6366, NoBusyQueue: 1647, NoBusyGroup: 24239609,
+		},
+		NewlyIdle: SchedstatLoadBalance{
+			Count: 2122447165, Balanced: 1886868564, Failed: 121112060, Imbalance: 2848625533,
+			Gained: 125678146, HotGained: 241025, NoBusyQueue: 1032026, NoBusyGroup: 1885836538,
+		},
+		ActiveLoadBalanceCount:  2545,
+		ActiveLoadBalanceFailed: 12,
+		ActiveLoadBalancePushed: 2533,
+		WakeRemote:              1387952561,
+		WakeMoveAffine:          21076581,
+		WakeMoveBalance:         0,
+	}}
+	if diff := cmp.Diff(want, cpu.Domains); diff != "" {
+		t.Errorf("unexpected cpu0 domains (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseSchedstatDomain(t *testing.T) {
+	line := "domain0 3 1 2 3 4 5 6 7 8 11 12 13 14 15 16 17 18 21 22 23 24 25 26 27 28 0 0 0 0 0 0 0 0 0 0 0 0"
+
+	v15, err := parseSchedstatDomain(line, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v16, err := parseSchedstatDomain(line, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(v15.Idle, v16.Busy); diff != "" {
+		t.Errorf("version 16 should swap idle and busy counters (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(v15.NewlyIdle, v16.NewlyIdle); diff != "" {
+		t.Errorf("unexpected newly idle counters (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseSchedstatDomain("domain0 3 1 2 3", 15); err == nil {
+		t.Error("truncated domain line should have been unparsable")
+	}
 }
 
 func TestProcSchedstat(t *testing.T) {
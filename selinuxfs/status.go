@@ -0,0 +1,129 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && !noselinux
+
+package selinuxfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// Boolean is the state of a single SELinux policy boolean.
+type Boolean struct {
+	// Current is the active value.
+	Current bool
+	// Pending is the value that becomes active on the next commit.
+	Pending bool
+}
+
+// Status contains the SELinux state from files in /sys/fs/selinux .
+type Status struct {
+	// Enforcing is true in enforcing mode and false in permissive mode.
+	Enforcing bool
+	// PolicyVersion is the highest policy version supported by the kernel.
+	PolicyVersion uint64
+	// MLS is true if the loaded policy enables multi-level security.
+	MLS *bool
+	// DenyUnknown is true if unknown object classes and permissions are
+	// denied.
+	DenyUnknown *bool
+	// Booleans holds the policy booleans keyed by name.
+	Booleans map[string]Boolean
+}
+
+// SelinuxStatus returns the SELinux enforcement mode, policy version and
+// booleans, or error on failure.
+func (fs FS) SelinuxStatus() (*Status, error) {
+	status := &Status{}
+
+	enforce, err := util.ReadUintFromFile(fs.selinux.Path("enforce"))
+	if err != nil {
+		return nil, err
+	}
+	status.Enforcing = enforce == 1
+
+	status.PolicyVersion, err = util.ReadUintFromFile(fs.selinux.Path("policyvers"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range [...]string{"mls", "deny_unknown"} {
+		v, err := util.ReadUintFromFile(fs.selinux.Path(f))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		b := v == 1
+		switch f {
+		case "mls":
+			status.MLS = &b
+		case "deny_unknown":
+			status.DenyUnknown = &b
+		}
+	}
+
+	status.Booleans, err = fs.parseBooleans()
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// parseBooleans reads the files in /sys/fs/selinux/booleans, which contain
+// the current and pending value like "1 0".
+func (fs FS) parseBooleans() (map[string]Boolean, error) {
+	path := fs.selinux.Path("booleans")
+	files, err := os.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	booleans := make(map[string]Boolean, len(files))
+	for _, f := range files {
+		file := filepath.Join(path, f.Name())
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			return nil, err
+		}
+
+		values := strings.Fields(string(data))
+		if len(values) != 2 {
+			return nil, fmt.Errorf("invalid SELinux boolean %q: %q", f.Name(), data)
+		}
+		current, err := strconv.ParseUint(values[0], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SELinux boolean %q: %w", f.Name(), err)
+		}
+		pending, err := strconv.ParseUint(values[1], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SELinux boolean %q: %w", f.Name(), err)
+		}
+
+		booleans[f.Name()] = Boolean{Current: current == 1, Pending: pending == 1}
+	}
+
+	return booleans, nil
+}
@@ -0,0 +1,55 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && !noselinux
+
+package selinuxfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSelinuxStatus(t *testing.T) {
+	fs, err := NewFS(selinuxTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.SelinuxStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mls         = true
+		denyUnknown = false
+	)
+
+	want := &Status{
+		Enforcing:     true,
+		PolicyVersion: 33,
+		MLS:           &mls,
+		DenyUnknown:   &denyUnknown,
+		Booleans: map[string]Boolean{
+			"container_manage_cgroup":   {Current: false, Pending: true},
+			"httpd_can_network_connect": {Current: true, Pending: true},
+			"virt_use_nfs":              {Current: false, Pending: false},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected SELinux status (-want +got):\n%s", diff)
+	}
+}
@@ -24,7 +24,8 @@ import (
 	"github.com/prometheus/procfs/internal/util"
 )
 
-// Softirqs represents the softirq statistics.
+// Softirqs represents the softirq statistics. Each slice holds one count per
+// CPU, in CPU order.
 type Softirqs struct {
 	Hi      []uint64
 	Timer   []uint64
@@ -38,6 +39,7 @@ type Softirqs struct {
 	RCU     []uint64
 }
 
+// Softirqs returns the per-CPU softirq counters read from /proc/softirqs .
 func (fs FS) Softirqs() (Softirqs, error) {
 	fileName := fs.proc.Path("softirqs")
 	data, err := util.ReadFileNoStat(fileName)
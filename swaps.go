@@ -25,13 +25,23 @@ import (
 
 // Swap represents an entry in /proc/swaps.
 type Swap struct {
+	// Path of the swap partition or file.
 	Filename string
-	Type     string
-	Size     int
-	Used     int
+	// Either "partition" or "file".
+	Type string
+	// Size of the swap area in KiB.
+	Size int
+	// Amount of the swap area in use in KiB.
+	Used int
+	// Priority of the swap area. Areas with a higher priority are used first.
 	Priority int
 }
 
+// Free returns the amount of the swap area not in use in KiB.
+func (s Swap) Free() int {
+	return s.Size - s.Used
+}
+
 // Swaps returns a slice of all configured swap devices on the system.
 func (fs FS) Swaps() ([]*Swap, error) {
 	data, err := util.ReadFileNoStat(fs.proc.Path("swaps"))
@@ -68,7 +78,7 @@ func parseSwapString(swapString string) (*Swap, error) {
 	}
 
 	swap := &Swap{
-		Filename: swapFields[0],
+		Filename: unescapeOctal(swapFields[0]),
 		Type:     swapFields[1],
 	}
 
@@ -87,3 +97,25 @@ func parseSwapString(swapString string) (*Swap, error) {
 
 	return swap, nil
 }
+
+// unescapeOctal decodes the octal escapes like "\040" the kernel uses for
+// whitespace and backslashes in paths, e.g. in /proc/swaps and mountinfo.
+func unescapeOctal(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if c, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
+}
@@ -50,6 +50,9 @@ func TestSwaps(t *testing.T) {
 	if swap.Priority != -2 {
 		t.Errorf("expected swap.Priority -2, got %d", swap.Priority)
 	}
+	if swap.Free() != 130892 {
+		t.Errorf("expected swap.Free() 130892, got %d", swap.Free())
+	}
 }
 
 func TestParseSwapString(t *testing.T) {
@@ -83,6 +86,18 @@ func TestParseSwapString(t *testing.T) {
 				Priority: -3,
 			},
 		},
+		{
+			name:    "Swap file with spaces",
+			s:       "/swap\\040file\\134x                         file            1048572 1024    -3",
+			invalid: false,
+			swap: &Swap{
+				Filename: "/swap file\\x",
+				Type:     "file",
+				Size:     1048572,
+				Used:     1024,
+				Priority: -3,
+			},
+		},
 		{
 			name:    "Invalid number",
 			s:       "/dev/sda2                               partition       hello   world   -2",
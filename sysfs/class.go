@@ -0,0 +1,116 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// ClassDevice is a single device in /sys/class/<Class> . It gives access to
+// the attributes of device classes without a dedicated parser.
+type ClassDevice struct {
+	Class string
+	Name  string
+	// Path is the absolute path of the device directory.
+	Path string
+}
+
+// ClassDevices returns the devices of the given class in /sys/class , sorted
+// by name.
+func (fs FS) ClassDevices(class string) ([]ClassDevice, error) {
+	path := fs.sys.Path("class", class)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]ClassDevice, 0, len(dirs))
+	for _, d := range dirs {
+		devices = append(devices, ClassDevice{
+			Class: class,
+			Name:  d.Name(),
+			Path:  filepath.Join(path, d.Name()),
+		})
+	}
+
+	return devices, nil
+}
+
+// ReadString returns the whitespace trimmed content of the attribute file,
+// which may be in a subdirectory like "power/control". Like all sysfs
+// reads in this package, at most 128 bytes are read.
+func (d ClassDevice) ReadString(attr string) (string, error) {
+	file := filepath.Join(d.Path, attr)
+	value, err := util.SysReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	return value, nil
+}
+
+// ReadUint returns the attribute parsed as a decimal unsigned integer.
+func (d ClassDevice) ReadUint(attr string) (uint64, error) {
+	value, err := d.ReadString(attr)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %q: %w", filepath.Join(d.Path, attr), err)
+	}
+	return v, nil
+}
+
+// ReadBool returns the attribute parsed as a boolean. The values accepted by
+// the kernel's kstrtobool, like "1", "Y", "true" or "on", are supported as
+// well as "enabled" and "disabled". As in kstrtobool only the leading
+// characters are significant.
+func (d ClassDevice) ReadBool(attr string) (bool, error) {
+	value, err := d.ReadString(attr)
+	if err != nil {
+		return false, err
+	}
+	switch value {
+	case "enabled":
+		return true, nil
+	case "disabled":
+		return false, nil
+	}
+	if len(value) > 0 {
+		switch value[0] {
+		case '1', 'y', 'Y', 't', 'T':
+			return true, nil
+		case '0', 'n', 'N', 'f', 'F':
+			return false, nil
+		case 'o', 'O':
+			if len(value) > 1 {
+				switch value[1] {
+				case 'n', 'N':
+					return true, nil
+				case 'f', 'F':
+					return false, nil
+				}
+			}
+		}
+	}
+	return false, fmt.Errorf("failed to parse %q: invalid boolean %q", filepath.Join(d.Path, attr), value)
+}
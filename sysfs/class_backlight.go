@@ -0,0 +1,109 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const backlightClassPath = "class/backlight"
+
+// Backlight contains info from files in /sys/class/backlight for a single
+// backlight device.
+// https://www.kernel.org/doc/Documentation/ABI/stable/sysfs-class-backlight
+type Backlight struct {
+	Name             string
+	Brightness       *uint64 // /sys/class/backlight/<Name>/brightness
+	MaxBrightness    *uint64 // /sys/class/backlight/<Name>/max_brightness
+	ActualBrightness *uint64 // /sys/class/backlight/<Name>/actual_brightness
+	Type             *string // /sys/class/backlight/<Name>/type, e.g. firmware or raw
+}
+
+// BacklightClass is a collection of every backlight device in
+// /sys/class/backlight .
+//
+// The map keys are the device names, like "intel_backlight".
+type BacklightClass map[string]Backlight
+
+// BacklightClass returns info for all backlight devices read from
+// /sys/class/backlight .
+func (fs FS) BacklightClass() (BacklightClass, error) {
+	path := fs.sys.Path(backlightClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backlight devices at %q: %w", path, err)
+	}
+
+	backlights := make(BacklightClass, len(dirs))
+	for _, d := range dirs {
+		backlight, err := fs.parseBacklight(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		backlights[backlight.Name] = *backlight
+	}
+
+	return backlights, nil
+}
+
+func (fs FS) parseBacklight(name string) (*Backlight, error) {
+	path := fs.sys.Path(backlightClassPath, name)
+	backlight := &Backlight{Name: name}
+
+	for _, f := range [...]string{"brightness", "max_brightness", "actual_brightness", "type"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "brightness":
+			backlight.Brightness = vp.PUInt64()
+		case "max_brightness":
+			backlight.MaxBrightness = vp.PUInt64()
+		case "actual_brightness":
+			backlight.ActualBrightness = vp.PUInt64()
+		case "type":
+			backlight.Type = &value
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return backlight, nil
+}
+
+// SetBrightness sets the brightness of the backlight by writing to
+// /sys/class/backlight/<Name>/brightness . The kernel rejects values above
+// MaxBrightness. The FS must have been created with NewWritableFS.
+func (b *Backlight) SetBrightness(fs FS, brightness uint64) error {
+	return fs.writeFile(fs.sys.Path(backlightClassPath, b.Name, "brightness"), strconv.FormatUint(brightness, 10))
+}
@@ -0,0 +1,89 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBacklightClass(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.BacklightClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := BacklightClass{
+		"intel_backlight": {
+			Name:             "intel_backlight",
+			Brightness:       makeUint64(4800),
+			MaxBrightness:    makeUint64(96000),
+			ActualBrightness: makeUint64(4800),
+			Type:             makeString("raw"),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected backlights (-want +got):\n%s", diff)
+	}
+}
+
+func TestBacklightSetBrightness(t *testing.T) {
+	tempDir := t.TempDir()
+
+	path := filepath.Join(tempDir, backlightClassPath, "acpi_video0")
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "brightness"), []byte("3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	backlight := Backlight{Name: "acpi_video0"}
+
+	roFS, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backlight.SetBrightness(roFS, 7); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("expected ErrReadOnlyFS setting brightness, got %v", err)
+	}
+
+	fs, err := NewWritableFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backlight.SetBrightness(fs, 7); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(path, "brightness"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "7" {
+		t.Errorf("unexpected brightness: %q", got)
+	}
+}
@@ -19,12 +19,54 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/prometheus/procfs/internal/util"
 )
 
 const dmiClassPath = "class/dmi/id"
 
+// dmiChassisTypes maps SMBIOS chassis type codes to their names, see the
+// System Enclosure or Chassis Types table of the SMBIOS specification.
+var dmiChassisTypes = map[uint64]string{
+	1:  "Other",
+	2:  "Unknown",
+	3:  "Desktop",
+	4:  "Low Profile Desktop",
+	5:  "Pizza Box",
+	6:  "Mini Tower",
+	7:  "Tower",
+	8:  "Portable",
+	9:  "Laptop",
+	10: "Notebook",
+	11: "Hand Held",
+	12: "Docking Station",
+	13: "All in One",
+	14: "Sub Notebook",
+	15: "Space-saving",
+	16: "Lunch Box",
+	17: "Main Server Chassis",
+	18: "Expansion Chassis",
+	19: "SubChassis",
+	20: "Bus Expansion Chassis",
+	21: "Peripheral Chassis",
+	22: "RAID Chassis",
+	23: "Rack Mount Chassis",
+	24: "Sealed-case PC",
+	25: "Multi-system Chassis",
+	26: "Compact PCI",
+	27: "Advanced TCA",
+	28: "Blade",
+	29: "Blade Enclosure",
+	30: "Tablet",
+	31: "Convertible",
+	32: "Detachable",
+	33: "IoT Gateway",
+	34: "Embedded PC",
+	35: "Mini PC",
+	36: "Stick PC",
+}
+
 // DMIClass contains info from files in /sys/class/dmi/id.
 type DMIClass struct {
 	BiosDate        *string // /sys/class/dmi/id/bios_date
@@ -128,3 +170,22 @@ func (fs FS) DMIClass() (*DMIClass, error) {
 
 	return &dmi, nil
 }
+
+// ChassisTypeName returns the SMBIOS name of ChassisType, e.g. "Rack Mount
+// Chassis". It returns an empty string if the chassis type is missing and
+// "Unknown" for codes not defined by the specification.
+func (dmi DMIClass) ChassisTypeName() string {
+	if dmi.ChassisType == nil {
+		return ""
+	}
+
+	// Bit 7 of the type byte is the chassis lock flag.
+	code, err := strconv.ParseUint(*dmi.ChassisType, 10, 8)
+	if err != nil {
+		return "Unknown"
+	}
+	if name, ok := dmiChassisTypes[code&0x7f]; ok {
+		return name
+	}
+	return "Unknown"
+}
@@ -78,3 +78,24 @@ func TestDMIClass(t *testing.T) {
 		t.Fatalf("unexpected DMI class (-want +got):\n%s", diff)
 	}
 }
+
+func TestDMIClassChassisTypeName(t *testing.T) {
+
+	tests := []struct {
+		chassisType *string
+		want        string
+	}{
+		{nil, ""},
+		{makeString("3"), "Desktop"},
+		{makeString("23"), "Rack Mount Chassis"},
+		{makeString("151"), "Rack Mount Chassis"},
+		{makeString("99"), "Unknown"},
+		{makeString("bogus"), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := (DMIClass{ChassisType: tt.chassisType}).ChassisTypeName(); got != tt.want {
+			t.Errorf("ChassisTypeName() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -66,7 +66,7 @@ func readDevInfo(dir string) (string, string, error) {
 
 // ClassDRMCardAMDGPUStats returns DRM card metrics for all amdgpu cards.
 func (fs FS) ClassDRMCardAMDGPUStats() ([]ClassDRMCardAMDGPUStats, error) {
-	cards, err := filepath.Glob(fs.sys.Path("class/drm/card[0-9]"))
+	cards, err := fs.drmCards()
 	if err != nil {
 		return nil, err
 	}
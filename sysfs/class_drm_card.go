@@ -17,7 +17,9 @@ package sysfs
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -27,9 +29,13 @@ const drmClassPath = "class/drm"
 // DRMCard contains info from files in /sys/class/drm for a
 // single DRM Card device.
 type DRMCard struct {
-	Name   string
-	Driver string
-	Ports  map[string]DRMCardPort
+	Name    string
+	Driver  string
+	Vendor  string // /sys/class/drm/<Card>/device/vendor
+	Device  string // /sys/class/drm/<Card>/device/device
+	Enable  *bool  // /sys/class/drm/<Card>/device/enable
+	BootVGA *bool  // /sys/class/drm/<Card>/device/boot_vga
+	Ports   map[string]DRMCardPort
 }
 
 // DRMCardPort contains info from files in
@@ -52,10 +58,9 @@ type DRMCardClass map[string]DRMCard
 // /sys/class/drm.
 func (fs FS) DRMCardClass() (DRMCardClass, error) {
 
-	cards, err := filepath.Glob(fs.sys.Path("class/drm/card[0-9]"))
-
+	cards, err := fs.drmCards()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list DRM card ports at %q: %w", cards, err)
+		return nil, err
 	}
 
 	drmCardClass := make(DRMCardClass, len(cards))
@@ -71,6 +76,23 @@ func (fs FS) DRMCardClass() (DRMCardClass, error) {
 	return drmCardClass, nil
 }
 
+// drmCards returns the paths of all cards in /sys/class/drm, skipping their
+// connectors like "card0-DP-1".
+func (fs FS) drmCards() ([]string, error) {
+	paths, err := filepath.Glob(fs.sys.Path(drmClassPath, "card[0-9]*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DRM cards: %w", err)
+	}
+
+	cards := paths[:0]
+	for _, p := range paths {
+		if !strings.Contains(filepath.Base(p), "-") {
+			cards = append(cards, p)
+		}
+	}
+	return cards, nil
+}
+
 // Parse one DRMCard.
 func (fs FS) parseDRMCard(name string) (*DRMCard, error) {
 	path := fs.sys.Path(drmClassPath, name)
@@ -83,6 +105,35 @@ func (fs FS) parseDRMCard(name string) (*DRMCard, error) {
 	}
 	card.Driver = filepath.Base(cardDriverPath)
 
+	// Not all buses expose all of these, e.g. platform devices on SoCs.
+	for _, f := range [...]string{"vendor", "device", "enable", "boot_vga"} {
+		name := filepath.Join(path, "device", f)
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "vendor":
+			card.Vendor = value
+		case "device":
+			card.Device = value
+		case "enable":
+			v := vp.Int() != 0
+			card.Enable = &v
+		case "boot_vga":
+			v := vp.Int() != 0
+			card.BootVGA = &v
+		}
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
 	portsPath, err := filepath.Glob(filepath.Join(path, filepath.Base(path)+"-*-*"))
 
 	if err != nil {
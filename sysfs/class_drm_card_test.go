@@ -32,15 +32,26 @@ func TestClassDRMCard(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	enabled := true
+	disabled := false
+
 	want := DRMCardClass{
 		"card0": DRMCard{
-			Name:   "card0",
-			Driver: "amdgpu",
-			Ports:  map[string]DRMCardPort{},
+			Name:    "card0",
+			Driver:  "amdgpu",
+			Vendor:  "0x1002",
+			Device:  "0x687f",
+			Enable:  &enabled,
+			BootVGA: &enabled,
+			Ports:   map[string]DRMCardPort{},
 		},
 		"card1": DRMCard{
-			Name:   "card1",
-			Driver: "i915",
+			Name:    "card1",
+			Driver:  "i915",
+			Vendor:  "0x8086",
+			Device:  "0x9a49",
+			Enable:  &enabled,
+			BootVGA: &disabled,
 			Ports: map[string]DRMCardPort{
 				"card1-DP-1": {
 					Name:    "card1-DP-1",
@@ -0,0 +1,199 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	fpgaManagerClassPath = "class/fpga_manager"
+	fpgaRegionClassPath  = "class/fpga_region"
+)
+
+// FpgaManager contains info from files in /sys/class/fpga_manager for a
+// single FPGA manager.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-fpga-manager
+type FpgaManager struct {
+	Name       string
+	DeviceName *string // /sys/class/fpga_manager/<Name>/name
+	State      *string // /sys/class/fpga_manager/<Name>/state, e.g. "operating" or "write error"
+	// Status holds the reconfiguration errors reported by the manager, one
+	// per line. /sys/class/fpga_manager/<Name>/status
+	Status      []string
+	PciLocation *PciDeviceLocation // PCI device the manager belongs to
+	// Regions are the names of the FPGA regions on the same PCI device.
+	Regions []string
+}
+
+// FpgaManagers is a collection of every FPGA manager in
+// /sys/class/fpga_manager .
+//
+// The map keys are the manager names, like "fpga0".
+type FpgaManagers map[string]FpgaManager
+
+// FpgaRegion contains info from files in /sys/class/fpga_region for a
+// single FPGA region.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-fpga-region
+type FpgaRegion struct {
+	Name        string
+	CompatID    *string            // /sys/class/fpga_region/<Name>/compat_id
+	PciLocation *PciDeviceLocation // PCI device the region belongs to
+}
+
+// FpgaRegions is a collection of every FPGA region in
+// /sys/class/fpga_region .
+//
+// The map keys are the region names, like "region0".
+type FpgaRegions map[string]FpgaRegion
+
+// FpgaManagers returns info for all FPGA managers read from
+// /sys/class/fpga_manager . Regions are linked to the manager of the same
+// PCI device; regions of platform devices are not linked.
+func (fs FS) FpgaManagers() (FpgaManagers, error) {
+	path := fs.sys.Path(fpgaManagerClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list FPGA managers at %q: %w", path, err)
+	}
+
+	regions, err := fs.FpgaRegions()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	managers := make(FpgaManagers, len(dirs))
+	for _, d := range dirs {
+		manager, err := fs.parseFpgaManager(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if manager.PciLocation != nil {
+			for _, region := range regions {
+				if region.PciLocation != nil && *region.PciLocation == *manager.PciLocation {
+					manager.Regions = append(manager.Regions, region.Name)
+				}
+			}
+			slices.Sort(manager.Regions)
+		}
+
+		managers[manager.Name] = *manager
+	}
+
+	return managers, nil
+}
+
+func (fs FS) parseFpgaManager(name string) (*FpgaManager, error) {
+	path := fs.sys.Path(fpgaManagerClassPath, name)
+	manager := &FpgaManager{Name: name}
+
+	for _, f := range [...]string{"name", "state"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		switch f {
+		case "name":
+			manager.DeviceName = &value
+		case "state":
+			manager.State = &value
+		}
+	}
+
+	file := filepath.Join(path, "status")
+	data, err := util.ReadFileNoStat(file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			manager.Status = append(manager.Status, line)
+		}
+	}
+
+	manager.PciLocation, err = fpgaPciLocation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+// FpgaRegions returns info for all FPGA regions read from
+// /sys/class/fpga_region .
+func (fs FS) FpgaRegions() (FpgaRegions, error) {
+	path := fs.sys.Path(fpgaRegionClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make(FpgaRegions, len(dirs))
+	for _, d := range dirs {
+		region := FpgaRegion{Name: d.Name()}
+
+		file := filepath.Join(path, d.Name(), "compat_id")
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+		} else {
+			region.CompatID = &value
+		}
+
+		region.PciLocation, err = fpgaPciLocation(filepath.Join(path, d.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		regions[region.Name] = region
+	}
+
+	return regions, nil
+}
+
+// fpgaPciLocation returns the location of the PCI device closest to the
+// class device, like 0000:00:08.0 for
+// "../../devices/pci0000:00/0000:00:08.0/dfl-fme.0/dfl-fme-mgr.0/fpga_manager/fpga0",
+// or nil if it is not a child of a PCI device.
+func fpgaPciLocation(path string) (*PciDeviceLocation, error) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to readlink %q: %w", path, err)
+	}
+
+	for dir := filepath.Dir(target); dir != "." && dir != "/" && filepath.Base(dir) != ".."; dir = filepath.Dir(dir) {
+		if location, err := ParsePciDeviceLocation(filepath.Base(dir)); err == nil {
+			return location, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFpgaManagers(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.FpgaManagers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := FpgaManagers{
+		"fpga0": {
+			Name:        "fpga0",
+			DeviceName:  makeString("DFL FME FPGA Manager"),
+			State:       makeString("write error"),
+			Status:      []string{"reconfig operation error", "reconfig validate error"},
+			PciLocation: &PciDeviceLocation{Segment: 0, Bus: 0, Device: 8, Function: 0},
+			Regions:     []string{"region1"},
+		},
+		"fpga1": {
+			Name:       "fpga1",
+			DeviceName: makeString("Altera SOCFPGA FPGA Manager"),
+			State:      makeString("operating"),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected FPGA managers (-want +got):\n%s", diff)
+	}
+}
+
+func TestFpgaRegions(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.FpgaRegions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compatID := "f3c9941350195d23b5f8a8c1da3e7fd2"
+
+	want := FpgaRegions{
+		"region0": {
+			Name: "region0",
+		},
+		"region1": {
+			Name:        "region1",
+			CompatID:    &compatID,
+			PciLocation: &PciDeviceLocation{Segment: 0, Bus: 0, Device: 8, Function: 0},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected FPGA regions (-want +got):\n%s", diff)
+	}
+}
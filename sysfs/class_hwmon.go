@@ -0,0 +1,180 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const hwmonClassPath = "class/hwmon"
+
+// hwmonSensorFile matches sensor attribute files like "temp1_input".
+var hwmonSensorFile = regexp.MustCompile(`^(temp|fan|in|power|curr)(\d+)_(input|label|min|max|crit|average|alarm)$`)
+
+// hwmonDivisor converts the raw sysfs values of each sensor type, in
+// millidegrees Celsius, RPM, millivolts, microwatts and milliamperes, to
+// base units.
+var hwmonDivisor = map[string]float64{
+	"temp":  1e3,
+	"fan":   1,
+	"in":    1e3,
+	"power": 1e6,
+	"curr":  1e3,
+}
+
+// HwmonSensor contains the values of a single hwmon sensor channel, like
+// /sys/class/hwmon/hwmon<N>/temp1_* . Values are converted to the base unit
+// of the sensor type.
+// https://www.kernel.org/doc/Documentation/hwmon/sysfs-interface.rst
+type HwmonSensor struct {
+	Label   *string  // <type><N>_label
+	Input   *float64 // <type><N>_input
+	Min     *float64 // <type><N>_min
+	Max     *float64 // <type><N>_max
+	Crit    *float64 // <type><N>_crit
+	Average *float64 // <type><N>_average, power sensors only
+	Alarm   *bool    // <type><N>_alarm
+}
+
+// HwmonDevice contains info from files in /sys/class/hwmon/hwmon<N> for a
+// single hardware monitoring chip.
+//
+// The sensor map keys are the channel names, like "temp1".
+type HwmonDevice struct {
+	Name     string                 // The name of the device from the directory structure, like "hwmon0".
+	ChipName string                 // /sys/class/hwmon/hwmon<N>/name
+	Temps    map[string]HwmonSensor // temp<N>_*, in degrees Celsius
+	Fans     map[string]HwmonSensor // fan<N>_*, in RPM
+	Voltages map[string]HwmonSensor // in<N>_*, in volts
+	Powers   map[string]HwmonSensor // power<N>_*, in watts
+	Currents map[string]HwmonSensor // curr<N>_*, in amperes
+
+	// ReadErrors contains any errors returned when gathering data. Broken
+	// sensors commonly fail to read, which does not affect the other sensors.
+	ReadErrors error
+}
+
+// HwmonDevices is a collection of every hwmon device in /sys/class/hwmon .
+//
+// The map keys are the device names, like "hwmon0".
+type HwmonDevices map[string]HwmonDevice
+
+// HwmonDevices returns the sensors of all hwmon devices read from
+// /sys/class/hwmon .
+func (fs FS) HwmonDevices() (HwmonDevices, error) {
+	dirs, err := filepath.Glob(fs.sys.Path(hwmonClassPath, "hwmon[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(HwmonDevices, len(dirs))
+	for _, dir := range dirs {
+		device, err := parseHwmonDevice(dir)
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func parseHwmonDevice(dir string) (*HwmonDevice, error) {
+	device := &HwmonDevice{Name: filepath.Base(dir)}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	chipName, err := util.SysReadFile(filepath.Join(dir, "name"))
+	if err != nil && !os.IsNotExist(err) {
+		errs = append(errs, fmt.Errorf("error reading name: %w", err))
+	}
+	device.ChipName = chipName
+
+	for _, f := range files {
+		match := hwmonSensorFile.FindStringSubmatch(f.Name())
+		if match == nil {
+			continue
+		}
+		sensorType, channel, attr := match[1], match[1]+match[2], match[3]
+
+		value, err := util.SysReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error reading %s: %w", f.Name(), err))
+			continue
+		}
+
+		var sensors *map[string]HwmonSensor
+		switch sensorType {
+		case "temp":
+			sensors = &device.Temps
+		case "fan":
+			sensors = &device.Fans
+		case "in":
+			sensors = &device.Voltages
+		case "power":
+			sensors = &device.Powers
+		case "curr":
+			sensors = &device.Currents
+		}
+		if *sensors == nil {
+			*sensors = map[string]HwmonSensor{}
+		}
+		sensor := (*sensors)[channel]
+
+		switch attr {
+		case "label":
+			sensor.Label = &value
+		case "alarm":
+			v := value != "0"
+			sensor.Alarm = &v
+		default:
+			raw, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error parsing %s: %w", f.Name(), err))
+				continue
+			}
+			v := float64(raw) / hwmonDivisor[sensorType]
+			switch attr {
+			case "input":
+				sensor.Input = &v
+			case "min":
+				sensor.Min = &v
+			case "max":
+				sensor.Max = &v
+			case "crit":
+				sensor.Crit = &v
+			case "average":
+				sensor.Average = &v
+			}
+		}
+
+		(*sensors)[channel] = sensor
+	}
+
+	device.ReadErrors = errors.Join(errs...)
+	return device, nil
+}
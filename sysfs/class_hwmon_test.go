@@ -0,0 +1,72 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHwmonDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.HwmonDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := HwmonDevices{
+		"hwmon1": {
+			Name:     "hwmon1",
+			ChipName: "nct6798",
+			Temps: map[string]HwmonSensor{
+				"temp1": {Label: makeString("SYSTIN"), Input: makeFloat64(36), Max: makeFloat64(80), Alarm: makeBool(true)},
+			},
+			Fans: map[string]HwmonSensor{
+				"fan1": {Input: makeFloat64(1246), Min: makeFloat64(200), Alarm: makeBool(false)},
+				"fan2": {Input: makeFloat64(0)},
+			},
+			Voltages: map[string]HwmonSensor{
+				"in0": {Label: makeString("Vcore"), Input: makeFloat64(1.032), Min: makeFloat64(0), Max: makeFloat64(1.744)},
+				"in1": {Input: makeFloat64(12.16)},
+			},
+			Powers: map[string]HwmonSensor{
+				"power1": {Input: makeFloat64(67.5), Average: makeFloat64(70), Max: makeFloat64(125)},
+			},
+			Currents: map[string]HwmonSensor{
+				"curr1": {Input: makeFloat64(2.5), Crit: makeFloat64(12)},
+			},
+		},
+		"hwmon3": {
+			Name:     "hwmon3",
+			ChipName: "nvme",
+			Temps: map[string]HwmonSensor{
+				"temp1": {Label: makeString("Composite"), Input: makeFloat64(43.85), Min: makeFloat64(-0.15), Max: makeFloat64(84.85), Crit: makeFloat64(94.85), Alarm: makeBool(false)},
+				"temp2": {Label: makeString("Sensor 1"), Input: makeFloat64(43.85), Min: makeFloat64(-273.15), Max: makeFloat64(65261.85)},
+				"temp3": {Label: makeString("Sensor 2"), Input: makeFloat64(45.85), Min: makeFloat64(-273.15), Max: makeFloat64(65261.85)},
+				"temp9": {Label: makeString("Sensor 8"), Input: makeFloat64(43.85), Min: makeFloat64(-273.15), Max: makeFloat64(65261.85)},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected hwmon devices (-want +got):\n%s", diff)
+	}
+}
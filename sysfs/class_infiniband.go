@@ -128,6 +128,9 @@ type InfiniBandDevice struct {
 	NodeGUID        string // /sys/class/infiniband/<Name>/node_guid
 	HCAType         string // /sys/class/infiniband/<Name>/hca_type
 	Ports           map[uint]InfiniBandPort
+	// PciLocation is the PCI device backing the HCA, nil for software
+	// devices like rxe. /sys/class/infiniband/<Name>/device
+	PciLocation *PciDeviceLocation
 }
 
 // InfiniBandClass is a collection of every InfiniBand device in
@@ -193,6 +196,12 @@ func (fs FS) parseInfiniBandDevice(name string) (*InfiniBandDevice, error) {
 		}
 	}
 
+	if target, err := os.Readlink(filepath.Join(path, "device")); err == nil {
+		if location, err := ParsePciDeviceLocation(filepath.Base(target)); err == nil {
+			device.PciLocation = location
+		}
+	}
+
 	portsPath := filepath.Join(path, "ports")
 	ports, err := os.ReadDir(portsPath)
 	if err != nil {
@@ -275,6 +275,7 @@ func TestInfiniBandClass(t *testing.T) {
 			FirmwareVersion: "14.28.2006",
 			HCAType:         "MT4118",
 			NodeGUID:        "0a7f:bc12:45ef:d23b",
+			PciLocation:     &PciDeviceLocation{Segment: 0, Bus: 0xa2, Device: 0, Function: 0},
 			Ports: map[uint]InfiniBandPort{
 				1: {
 					LinkLayer:   "InfiniBand",
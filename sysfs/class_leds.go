@@ -0,0 +1,126 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const ledClassPath = "class/leds"
+
+// LED contains info from files in /sys/class/leds for a single LED.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-led
+type LED struct {
+	Name          string
+	Brightness    *uint64 // /sys/class/leds/<Name>/brightness
+	MaxBrightness *uint64 // /sys/class/leds/<Name>/max_brightness
+	// Trigger is the active trigger, "none" if the LED is controlled
+	// through its brightness only. /sys/class/leds/<Name>/trigger
+	Trigger *string
+	// Triggers are all triggers that can be selected for the LED.
+	Triggers []string
+}
+
+// LEDClass is a collection of every LED in /sys/class/leds .
+//
+// The map keys are the LED names, like "input3::capslock".
+type LEDClass map[string]LED
+
+// LEDClass returns info for all LEDs read from /sys/class/leds .
+func (fs FS) LEDClass() (LEDClass, error) {
+	path := fs.sys.Path(ledClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LEDs at %q: %w", path, err)
+	}
+
+	leds := make(LEDClass, len(dirs))
+	for _, d := range dirs {
+		led, err := fs.parseLED(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		leds[led.Name] = *led
+	}
+
+	return leds, nil
+}
+
+func (fs FS) parseLED(name string) (*LED, error) {
+	path := fs.sys.Path(ledClassPath, name)
+	led := &LED{Name: name}
+
+	for _, f := range [...]string{"brightness", "max_brightness"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "brightness":
+			led.Brightness = vp.PUInt64()
+		case "max_brightness":
+			led.MaxBrightness = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	// The trigger file lists every available trigger, which can exceed the
+	// size read by SysReadFile.
+	file := filepath.Join(path, "trigger")
+	data, err := util.ReadFileNoStat(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return led, nil
+		}
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	// The active trigger is enclosed in brackets, like
+	// "none timer [heartbeat] default-on".
+	for trigger := range strings.FieldsSeq(string(data)) {
+		if active, ok := strings.CutPrefix(trigger, "["); ok {
+			trigger = strings.TrimSuffix(active, "]")
+			led.Trigger = &trigger
+		}
+		led.Triggers = append(led.Triggers, trigger)
+	}
+
+	return led, nil
+}
+
+// SetBrightness sets the brightness of the LED by writing to
+// /sys/class/leds/<Name>/brightness . Writing 0 also disables the active
+// trigger. The FS must have been created with NewWritableFS.
+func (led *LED) SetBrightness(fs FS, brightness uint64) error {
+	return fs.writeFile(fs.sys.Path(ledClassPath, led.Name, "brightness"), strconv.FormatUint(brightness, 10))
+}
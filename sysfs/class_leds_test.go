@@ -0,0 +1,99 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLEDClass(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.LEDClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := LEDClass{
+		"input3::capslock": {
+			Name:          "input3::capslock",
+			Brightness:    makeUint64(1),
+			MaxBrightness: makeUint64(1),
+			Trigger:       makeString("kbd-capslock"),
+			Triggers:      []string{"none", "kbd-scrolllock", "kbd-numlock", "kbd-capslock", "kbd-kanalock"},
+		},
+		"status:green": {
+			Name:          "status:green",
+			Brightness:    makeUint64(0),
+			MaxBrightness: makeUint64(255),
+			Trigger:       makeString("none"),
+			Triggers:      []string{"none", "timer", "heartbeat", "default-on"},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected LEDs (-want +got):\n%s", diff)
+	}
+}
+
+func TestLEDSetBrightness(t *testing.T) {
+	tempDir := t.TempDir()
+
+	path := filepath.Join(tempDir, ledClassPath, "status:green")
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "brightness"), []byte("0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	roFS, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leds, err := roFS.LEDClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+	led := leds["status:green"]
+	if err := led.SetBrightness(roFS, 1); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("expected ErrReadOnlyFS setting brightness, got %v", err)
+	}
+
+	fs, err := NewWritableFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := led.SetBrightness(fs, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	leds, err = fs.LEDClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := leds["status:green"].Brightness; got == nil || *got != 1 {
+		t.Errorf("unexpected brightness: %v", got)
+	}
+}
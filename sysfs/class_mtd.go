@@ -0,0 +1,129 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const mtdClassPath = "class/mtd"
+
+// MTDDevice contains info from files in /sys/class/mtd for a single MTD
+// flash device or partition.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-mtd
+type MTDDevice struct {
+	Name             string
+	DeviceName       *string // /sys/class/mtd/<Name>/name
+	Type             *string // /sys/class/mtd/<Name>/type, e.g. nand or nor
+	Size             *uint64 // /sys/class/mtd/<Name>/size
+	EraseSize        *uint64 // /sys/class/mtd/<Name>/erasesize
+	WriteSize        *uint64 // /sys/class/mtd/<Name>/writesize
+	OOBSize          *uint64 // /sys/class/mtd/<Name>/oobsize
+	BadBlocks        *uint64 // /sys/class/mtd/<Name>/bad_blocks
+	BBTBlocks        *uint64 // /sys/class/mtd/<Name>/bbt_blocks
+	ECCFailures      *uint64 // /sys/class/mtd/<Name>/ecc_failures
+	CorrectedBits    *uint64 // /sys/class/mtd/<Name>/corrected_bits
+	ECCStrength      *uint64 // /sys/class/mtd/<Name>/ecc_strength
+	BitflipThreshold *uint64 // /sys/class/mtd/<Name>/bitflip_threshold
+}
+
+// MTDClass is a collection of every MTD device in /sys/class/mtd . The
+// read-only mtd<N>ro entries are skipped.
+//
+// The map keys are the device names, like "mtd0".
+type MTDClass map[string]MTDDevice
+
+// MTDClass returns info for all MTD devices read from /sys/class/mtd .
+func (fs FS) MTDClass() (MTDClass, error) {
+	path := fs.sys.Path(mtdClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MTD devices at %q: %w", path, err)
+	}
+
+	devices := make(MTDClass, len(dirs))
+	for _, d := range dirs {
+		if strings.HasSuffix(d.Name(), "ro") {
+			continue
+		}
+
+		device, err := fs.parseMTDDevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseMTDDevice(name string) (*MTDDevice, error) {
+	path := fs.sys.Path(mtdClassPath, name)
+	device := &MTDDevice{Name: name}
+
+	for _, f := range [...]string{"name", "type", "size", "erasesize", "writesize", "oobsize", "bad_blocks", "bbt_blocks", "ecc_failures", "corrected_bits", "ecc_strength", "bitflip_threshold"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "name":
+			device.DeviceName = &value
+		case "type":
+			device.Type = &value
+		case "size":
+			device.Size = vp.PUInt64()
+		case "erasesize":
+			device.EraseSize = vp.PUInt64()
+		case "writesize":
+			device.WriteSize = vp.PUInt64()
+		case "oobsize":
+			device.OOBSize = vp.PUInt64()
+		case "bad_blocks":
+			device.BadBlocks = vp.PUInt64()
+		case "bbt_blocks":
+			device.BBTBlocks = vp.PUInt64()
+		case "ecc_failures":
+			device.ECCFailures = vp.PUInt64()
+		case "corrected_bits":
+			device.CorrectedBits = vp.PUInt64()
+		case "ecc_strength":
+			device.ECCStrength = vp.PUInt64()
+		case "bitflip_threshold":
+			device.BitflipThreshold = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return device, nil
+}
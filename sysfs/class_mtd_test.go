@@ -0,0 +1,65 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMTDClass(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.MTDClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := MTDClass{
+		"mtd0": {
+			Name:             "mtd0",
+			DeviceName:       makeString("ubi"),
+			Type:             makeString("nand"),
+			Size:             makeUint64(268435456),
+			EraseSize:        makeUint64(131072),
+			WriteSize:        makeUint64(2048),
+			OOBSize:          makeUint64(64),
+			BadBlocks:        makeUint64(3),
+			BBTBlocks:        makeUint64(4),
+			ECCFailures:      makeUint64(0),
+			CorrectedBits:    makeUint64(127),
+			ECCStrength:      makeUint64(4),
+			BitflipThreshold: makeUint64(3),
+		},
+		"mtd1": {
+			Name:       "mtd1",
+			DeviceName: makeString("u-boot"),
+			Type:       makeString("nor"),
+			Size:       makeUint64(1048576),
+			EraseSize:  makeUint64(65536),
+			WriteSize:  makeUint64(1),
+			OOBSize:    makeUint64(0),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected MTD devices (-want +got):\n%s", diff)
+	}
+}
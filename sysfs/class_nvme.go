@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -36,6 +37,7 @@ type NVMeNamespace struct {
 	SizeBlocks       uint64 // from size file (total blocks)
 	LogicalBlockSize uint64 // from queue/logical_block_size file
 	ANAState         string // from ana_state file
+	WWID             string // from wwid file
 	UsedBytes        uint64 // calculated: UsedBlocks * LogicalBlockSize
 	SizeBytes        uint64 // calculated: SizeBlocks * LogicalBlockSize
 	CapacityBytes    uint64 // calculated: SizeBlocks * LogicalBlockSize
@@ -49,7 +51,12 @@ type NVMeDevice struct {
 	State            string          // /sys/class/nvme/<Name>/state
 	FirmwareRevision string          // /sys/class/nvme/<Name>/firmware_rev
 	ControllerID     string          // /sys/class/nvme/<Name>/cntlid
+	Transport        string          // /sys/class/nvme/<Name>/transport
+	SubsystemNQN     string          // /sys/class/nvme/<Name>/subsysnqn
 	Namespaces       []NVMeNamespace // NVMe namespaces for this device
+	// PciLocation is the PCI device of the controller, nil for fabrics
+	// controllers. /sys/class/nvme/<Name>/device
+	PciLocation *PciDeviceLocation
 }
 
 // NVMeClass is a collection of every NVMe device in /sys/class/nvme.
@@ -106,6 +113,33 @@ func (fs FS) parseNVMeDevice(name string) (*NVMeDevice, error) {
 		}
 	}
 
+	for _, f := range [...]string{"transport", "subsysnqn"} {
+		name := filepath.Join(path, f)
+		// An NQN can be up to 223 bytes, longer than util.SysReadFile reads.
+		data, err := util.ReadFileNoStat(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+		value := strings.TrimSpace(string(data))
+
+		switch f {
+		case "transport":
+			device.Transport = value
+		case "subsysnqn":
+			device.SubsystemNQN = value
+		}
+	}
+
+	// PCIe controllers link to their PCI device, like "../../../0000:01:00.0".
+	if target, err := os.Readlink(filepath.Join(path, "device")); err == nil {
+		if location, err := ParsePciDeviceLocation(filepath.Base(target)); err == nil {
+			device.PciLocation = location
+		}
+	}
+
 	// Parse namespaces - read directory and filter using regex
 	dirs, err := os.ReadDir(path)
 	if err != nil {
@@ -130,12 +164,12 @@ func (fs FS) parseNVMeDevice(name string) (*NVMeDevice, error) {
 		}
 
 		// Parse namespace attributes using the same approach as device attributes
-		for _, f := range [...]string{"nuse", "size", "queue/logical_block_size", "ana_state"} {
+		for _, f := range [...]string{"nuse", "size", "queue/logical_block_size", "ana_state", "wwid"} {
 			filePath := filepath.Join(namespacePath, f)
 			value, err := util.SysReadFile(filePath)
 			if err != nil {
-				if f == "ana_state" {
-					// ana_state may not exist, skip silently
+				if f == "ana_state" || f == "wwid" {
+					// ana_state and wwid may not exist, skip silently
 					continue
 				}
 				return nil, fmt.Errorf("failed to read file %q: %w", filePath, err)
@@ -156,6 +190,8 @@ func (fs FS) parseNVMeDevice(name string) (*NVMeDevice, error) {
 				}
 			case "ana_state":
 				namespace.ANAState = value
+			case "wwid":
+				namespace.WWID = value
 			}
 		}
 
@@ -48,6 +48,10 @@ func TestNVMeClass(t *testing.T) {
 			Serial:           "S680HF8N190894I",
 			State:            "live",
 			ControllerID:     "1997",
+			Transport:        "pcie",
+			// A full-length, 223 byte NQN.
+			SubsystemNQN: "nqn.2014-08.com.samsung:nvme:970-pro:S680HF8N190894I:vol-01.vol-02.vol-03.vol-04.vol-05.vol-06.vol-07.vol-08.vol-09.vol-10.vol-11.vol-12.vol-13.vol-14.vol-15.vol-16.vol-17.vol-18.vol-19.vol-20.vol-21.vol-22.vol-23.volume-24",
+			PciLocation:  &PciDeviceLocation{Segment: 0, Bus: 1, Device: 0, Function: 0},
 			Namespaces: []NVMeNamespace{
 				{
 					ID:               "0",
@@ -55,6 +59,7 @@ func TestNVMeClass(t *testing.T) {
 					SizeBlocks:       3906250000,
 					LogicalBlockSize: 4096,
 					ANAState:         "optimized",
+					WWID:             "eui.0025385b71b07e2f",
 					UsedBytes:        2000000000000,
 					SizeBytes:        16000000000000,
 					CapacityBytes:    16000000000000,
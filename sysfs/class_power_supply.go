@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -95,6 +96,11 @@ type PowerSupply struct {
 	VoltageMinDesign         *int64 // /sys/class/power_supply/<Name>/voltage_min_design
 	VoltageNow               *int64 // /sys/class/power_supply/<Name>/voltage_now
 	VoltageOCV               *int64 // /sys/class/power_supply/<Name>/voltage_ocv
+
+	// Uevent holds the properties from /sys/class/power_supply/<Name>/uevent
+	// with the POWER_SUPPLY_ prefix removed, like "CAPACITY". Unlike the
+	// individual files, they are read at once from a single snapshot.
+	Uevent map[string]string
 }
 
 // PowerSupplyClass is a collection of every power supply in
@@ -140,6 +146,15 @@ func parsePowerSupply(path string) (*PowerSupply, error) {
 		}
 
 		name := filepath.Join(path, f.Name())
+		if f.Name() == "uevent" {
+			uevent, err := parsePowerSupplyUevent(name)
+			if err != nil {
+				return nil, err
+			}
+			ps.Uevent = uevent
+			continue
+		}
+
 		value, err := util.SysReadFile(name)
 		if err != nil {
 			if os.IsNotExist(err) || err.Error() == "operation not supported" || err.Error() == "no such device" || errors.Is(err, os.ErrInvalid) {
@@ -294,3 +309,26 @@ func parsePowerSupply(path string) (*PowerSupply, error) {
 
 	return &ps, nil
 }
+
+// parsePowerSupplyUevent parses the POWER_SUPPLY_<KEY>=<value> lines of a
+// power supply uevent file.
+func parsePowerSupplyUevent(path string) (map[string]string, error) {
+	data, err := util.ReadFileNoStat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	uevent := map[string]string{}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid uevent line %q in %q", line, path)
+		}
+		uevent[strings.TrimPrefix(key, "POWER_SUPPLY_")] = value
+	}
+
+	return uevent, nil
+}
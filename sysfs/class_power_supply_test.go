@@ -50,6 +50,10 @@ func TestPowerSupplyClass(t *testing.T) {
 			Name:   "AC",
 			Type:   "Mains",
 			Online: &acOnline,
+			Uevent: map[string]string{
+				"NAME":   "AC",
+				"ONLINE": "0",
+			},
 		},
 		"BAT0": {
 			Name:             "BAT0",
@@ -69,6 +73,24 @@ func TestPowerSupplyClass(t *testing.T) {
 			Type:             "Battery",
 			VoltageMinDesign: &bat0VoltageMinDesign,
 			VoltageNow:       &bat0VoltageNow,
+			Uevent: map[string]string{
+				"NAME":               "BAT0",
+				"STATUS":             "Discharging",
+				"PRESENT":            "1",
+				"TECHNOLOGY":         "Li-ion",
+				"CYCLE_COUNT":        "0",
+				"VOLTAGE_MIN_DESIGN": "10800000",
+				"VOLTAGE_NOW":        "11750000",
+				"POWER_NOW":          "5064000",
+				"ENERGY_FULL_DESIGN": "47520000",
+				"ENERGY_FULL":        "47390000",
+				"ENERGY_NOW":         "40730000",
+				"CAPACITY":           "85",
+				"CAPACITY_LEVEL":     "Normal",
+				"MODEL_NAME":         "LNV-45N1",
+				"MANUFACTURER":       "LGC",
+				"SERIAL_NUMBER":      "38109",
+			},
 		},
 	}
 
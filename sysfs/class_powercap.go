@@ -16,11 +16,13 @@
 package sysfs
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -31,12 +33,29 @@ type RaplZone struct {
 	Index          int    // index (different value for duplicate names)
 	Path           string // filesystem path of RaplZone
 	MaxMicrojoules uint64 // max RAPL microjoule value
+
+	Constraints []RaplConstraint // power limits from files "constraint_<N>_*"
+}
+
+// RaplConstraint contains a power limit of a RAPL zone from files
+// constraint_<N>_* , where N is the index in RaplZone.Constraints.
+type RaplConstraint struct {
+	Name                   string  // constraint_<N>_name, like "long_term"
+	PowerLimitMicrowatts   *uint64 // constraint_<N>_power_limit_uw
+	TimeWindowMicroseconds *uint64 // constraint_<N>_time_window_us
+	MaxPowerMicrowatts     *uint64 // constraint_<N>_max_power_uw, nil if not reported
 }
 
 // GetRaplZones returns a slice of RaplZones. When RAPL files are not present,
 // returns nil with error.
 // - https://www.kernel.org/doc/Documentation/power/powercap/powercap.txt
 func GetRaplZones(fs FS) ([]RaplZone, error) {
+	return fs.RaplZones()
+}
+
+// RaplZones returns a slice of RaplZones read from /sys/class/powercap.
+// When RAPL files are not present, returns nil with error.
+func (fs FS) RaplZones() ([]RaplZone, error) {
 	raplDir := fs.sys.Path("class/powercap")
 
 	files, err := os.ReadDir(raplDir)
@@ -68,11 +87,17 @@ func GetRaplZones(fs FS) ([]RaplZone, error) {
 				return nil, err
 			}
 
+			constraints, err := parseRaplConstraints(filepath.Join(raplDir, f.Name()))
+			if err != nil {
+				return nil, err
+			}
+
 			zone := RaplZone{
 				Name:           name,
 				Index:          index,
 				Path:           filepath.Join(raplDir, f.Name()),
 				MaxMicrojoules: maxMicrojoules,
+				Constraints:    constraints,
 			}
 
 			zones = append(zones, zone)
@@ -93,6 +118,65 @@ func (rz RaplZone) GetEnergyMicrojoules() (uint64, error) {
 	return util.ReadUintFromFile(filepath.Join(rz.Path, "/energy_uj"))
 }
 
+// EnergyDelta returns the energy in microjoules consumed between two
+// readings of GetEnergyMicrojoules. The counter wraps around to 0 after
+// MaxMicrojoules, which is accounted for when cur is less than prev; at most
+// one wrap-around between the readings can be detected.
+func (rz RaplZone) EnergyDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return rz.MaxMicrojoules - prev + cur + 1
+	}
+	return cur - prev
+}
+
+// parseRaplConstraints reads the constraint_<N>_* files of a RAPL zone,
+// starting at constraint 0 until no constraint_<N>_name file is found.
+func parseRaplConstraints(zonePath string) ([]RaplConstraint, error) {
+	var constraints []RaplConstraint
+
+	for i := 0; ; i++ {
+		prefix := filepath.Join(zonePath, fmt.Sprintf("constraint_%d_", i))
+		name, err := util.SysReadFile(prefix + "name")
+		if err != nil {
+			if os.IsNotExist(err) {
+				return constraints, nil
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", prefix+"name", err)
+		}
+
+		constraint := RaplConstraint{Name: name}
+		for _, f := range [...]string{"power_limit_uw", "time_window_us", "max_power_uw"} {
+			file := prefix + f
+			value, err := util.SysReadFile(file)
+			if err != nil {
+				// max_power_uw reports ENODATA when the limit is unknown.
+				if os.IsNotExist(err) || errors.Is(err, syscall.ENODATA) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+			if value == "" {
+				continue
+			}
+
+			vp := util.NewValueParser(value)
+			switch f {
+			case "power_limit_uw":
+				constraint.PowerLimitMicrowatts = vp.PUInt64()
+			case "time_window_us":
+				constraint.TimeWindowMicroseconds = vp.PUInt64()
+			case "max_power_uw":
+				constraint.MaxPowerMicrowatts = vp.PUInt64()
+			}
+			if err := vp.Err(); err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+			}
+		}
+
+		constraints = append(constraints, constraint)
+	}
+}
+
 // getIndexAndName returns a pair of (index, name) for a given name and name
 // counting map. Some RAPL-names have an index at the end, some have duplicates
 // without an index at the end. When the index is embedded in the name, it is
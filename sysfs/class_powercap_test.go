@@ -18,6 +18,8 @@ package sysfs
 import (
 	"path/filepath"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestGetRaplZones(t *testing.T) {
@@ -70,3 +72,43 @@ func TestNewRaplValues(t *testing.T) {
 		t.Fatal("wrong index number")
 	}
 }
+
+func TestRaplZoneConstraints(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zones, err := fs.RaplZones()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]RaplConstraint{
+		"package": {
+			{Name: "long_term", PowerLimitMicrowatts: makeUint64(4090000000), TimeWindowMicroseconds: makeUint64(999424), MaxPowerMicrowatts: makeUint64(95000000)},
+			{Name: "short_term", PowerLimitMicrowatts: makeUint64(4090000000), TimeWindowMicroseconds: makeUint64(2440), MaxPowerMicrowatts: makeUint64(0)},
+		},
+		"core": {
+			{Name: "long_term", PowerLimitMicrowatts: makeUint64(0), TimeWindowMicroseconds: makeUint64(976)},
+		},
+	}
+
+	for _, zone := range zones[:2] {
+		if diff := cmp.Diff(want[zone.Name], zone.Constraints); diff != "" {
+			t.Errorf("unexpected constraints for %s (-want +got):\n%s", zone.Name, diff)
+		}
+	}
+}
+
+func TestRaplZoneEnergyDelta(t *testing.T) {
+	zone := RaplZone{MaxMicrojoules: 262143328850}
+
+	if got := zone.EnergyDelta(1000, 5000); got != 4000 {
+		t.Errorf("unexpected delta: want 4000, got %d", got)
+	}
+	// The counter wrapped around from 262143328000 through 0 to 500.
+	if got := zone.EnergyDelta(262143328000, 500); got != 1351 {
+		t.Errorf("unexpected delta after wrap-around: want 1351, got %d", got)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const ptpClassPath = "class/ptp"
+
+// PtpClock contains info from files in /sys/class/ptp for a single PTP
+// hardware clock.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-ptp
+type PtpClock struct {
+	Name              string
+	ClockName         *string // /sys/class/ptp/<Name>/clock_name
+	MaxAdjustment     *int64  // /sys/class/ptp/<Name>/max_adjustment, in parts per billion
+	NAlarm            *int64  // /sys/class/ptp/<Name>/n_alarm
+	NExtTimestamps    *int64  // /sys/class/ptp/<Name>/n_external_timestamps
+	NPeriodicOutputs  *int64  // /sys/class/ptp/<Name>/n_periodic_outputs
+	NProgrammablePins *int64  // /sys/class/ptp/<Name>/n_programmable_pins
+	PPSAvailable      *bool   // /sys/class/ptp/<Name>/pps_available
+	// NetInterfaces are the network interfaces of the device implementing
+	// the clock. It is empty for clocks without a parent device, like
+	// kvm_ptp. /sys/class/ptp/<Name>/device/net
+	NetInterfaces []string
+}
+
+// PtpClocks is a collection of every PTP hardware clock in /sys/class/ptp .
+//
+// The map keys are the clock names, like "ptp0".
+type PtpClocks map[string]PtpClock
+
+// PtpClocks returns info for all PTP hardware clocks read from
+// /sys/class/ptp .
+func (fs FS) PtpClocks() (PtpClocks, error) {
+	path := fs.sys.Path(ptpClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PTP clocks at %q: %w", path, err)
+	}
+
+	clocks := make(PtpClocks, len(dirs))
+	for _, d := range dirs {
+		clock, err := fs.parsePtpClock(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		clocks[clock.Name] = *clock
+	}
+
+	return clocks, nil
+}
+
+func (fs FS) parsePtpClock(name string) (*PtpClock, error) {
+	path := fs.sys.Path(ptpClassPath, name)
+	clock := &PtpClock{Name: name}
+
+	for _, f := range [...]string{"clock_name", "max_adjustment", "n_alarm", "n_external_timestamps", "n_periodic_outputs", "n_programmable_pins", "pps_available"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "clock_name":
+			clock.ClockName = &value
+		case "max_adjustment":
+			clock.MaxAdjustment = vp.PInt64()
+		case "n_alarm":
+			clock.NAlarm = vp.PInt64()
+		case "n_external_timestamps":
+			clock.NExtTimestamps = vp.PInt64()
+		case "n_periodic_outputs":
+			clock.NPeriodicOutputs = vp.PInt64()
+		case "n_programmable_pins":
+			clock.NProgrammablePins = vp.PInt64()
+		case "pps_available":
+			v := vp.Int() != 0
+			clock.PPSAvailable = &v
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	netPath := filepath.Join(path, "device", "net")
+	ifaces, err := os.ReadDir(netPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to list network interfaces at %q: %w", netPath, err)
+	}
+	for _, iface := range ifaces {
+		clock.NetInterfaces = append(clock.NetInterfaces, iface.Name())
+	}
+
+	return clock, nil
+}
@@ -0,0 +1,62 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPtpClocks(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.PtpClocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PtpClocks{
+		"ptp0": {
+			Name:              "ptp0",
+			ClockName:         makeString("igb"),
+			MaxAdjustment:     makeInt64(62499999),
+			NAlarm:            makeInt64(0),
+			NExtTimestamps:    makeInt64(2),
+			NPeriodicOutputs:  makeInt64(2),
+			NProgrammablePins: makeInt64(4),
+			PPSAvailable:      makeBool(true),
+			NetInterfaces:     []string{"eno1"},
+		},
+		"ptp1": {
+			Name:              "ptp1",
+			ClockName:         makeString("KVM virtual PTP"),
+			MaxAdjustment:     makeInt64(0),
+			NAlarm:            makeInt64(0),
+			NExtTimestamps:    makeInt64(0),
+			NPeriodicOutputs:  makeInt64(0),
+			NProgrammablePins: makeInt64(0),
+			PPSAvailable:      makeBool(false),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected PTP clocks (-want +got):\n%s", diff)
+	}
+}
@@ -16,10 +16,13 @@
 package sysfs
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"slices"
+	"syscall"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -31,11 +34,14 @@ const (
 )
 
 type SASDevice struct {
-	Name         string   // /sys/class/sas_device/<Name>
-	SASAddress   string   // /sys/class/sas_device/<Name>/sas_address
-	SASPhys      []string // /sys/class/sas_device/<Name>/device/phy-*
-	SASPorts     []string // /sys/class/sas_device/<Name>/device/ports-*
-	BlockDevices []string // /sys/class/sas_device/<Name>/device/target*/*/block/*
+	Name                string   // /sys/class/sas_device/<Name>
+	SASAddress          string   // /sys/class/sas_device/<Name>/sas_address
+	DeviceType          string   // /sys/class/sas_device/<Name>/device_type
+	EnclosureIdentifier string   // /sys/class/sas_device/<Name>/enclosure_identifier
+	BayIdentifier       string   // /sys/class/sas_device/<Name>/bay_identifier
+	SASPhys             []string // /sys/class/sas_device/<Name>/device/phy-*
+	SASPorts            []string // /sys/class/sas_device/<Name>/device/ports-*
+	BlockDevices        []string // /sys/class/sas_device/<Name>/device/target*/*/block/*
 }
 
 type SASDeviceClass map[string]*SASDevice
@@ -117,6 +123,28 @@ func (fs FS) parseSASDevice(name string) (*SASDevice, error) {
 	}
 	device.SASAddress = value
 
+	// The enclosure and bay identifiers are only known for end devices
+	// behind an enclosure; reading them fails otherwise.
+	for _, f := range [...]string{"device_type", "enclosure_identifier", "bay_identifier"} {
+		file := fs.sys.Path(sasDeviceClassPath, name, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) || errors.Is(err, syscall.EINVAL) || errors.Is(err, syscall.ENXIO) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		switch f {
+		case "device_type":
+			device.DeviceType = value
+		case "enclosure_identifier":
+			device.EnclosureIdentifier = value
+		case "bay_identifier":
+			device.BayIdentifier = value
+		}
+	}
+
 	device.BlockDevices, err = fs.blockSASDeviceBlockDevices(name)
 	if err != nil {
 		return nil, err
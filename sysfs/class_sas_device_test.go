@@ -34,28 +34,41 @@ func TestSASDeviceClass(t *testing.T) {
 
 	want := SASDeviceClass{
 		"end_device-11:0:0": {
-			Name:         "end_device-11:0:0",
-			SASAddress:   "0x5000ccab02009402",
-			BlockDevices: []string{"sdv"},
+			Name:                "end_device-11:0:0",
+			SASAddress:          "0x5000ccab02009402",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "58",
+			BlockDevices:        []string{"sdv"},
 		},
 		"end_device-11:0:1": {
-			Name:         "end_device-11:0:1",
-			SASAddress:   "0x5000cca26128b1f5",
-			BlockDevices: []string{"sdw"},
+			Name:                "end_device-11:0:1",
+			SASAddress:          "0x5000cca26128b1f5",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "23",
+			BlockDevices:        []string{"sdw"},
 		},
 		"end_device-11:0:2": {
-			Name:         "end_device-11:0:2",
-			SASAddress:   "0x5000ccab02009406",
-			BlockDevices: []string{"sdx"},
+			Name:                "end_device-11:0:2",
+			SASAddress:          "0x5000ccab02009406",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "57",
+			BlockDevices:        []string{"sdx"},
 		},
 		"end_device-11:2": {
-			Name:         "end_device-11:2",
-			SASAddress:   "0x5000cca0506b5f1d",
-			BlockDevices: []string{"sdp"},
+			Name:                "end_device-11:2",
+			SASAddress:          "0x5000cca0506b5f1d",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x500062b2047b51c0",
+			BayIdentifier:       "0",
+			BlockDevices:        []string{"sdp"},
 		},
 		"expander-11:0": {
 			Name:       "expander-11:0",
 			SASAddress: "0x5000ccab0200947e",
+			DeviceType: "edge expander",
 			SASPhys: []string{
 				"phy-11:0:10", "phy-11:0:11", "phy-11:0:12",
 				"phy-11:0:13", "phy-11:0:14", "phy-11:0:15",
@@ -70,6 +83,7 @@ func TestSASDeviceClass(t *testing.T) {
 		"expander-11:1": {
 			Name:       "expander-11:1",
 			SASAddress: "0x5003048001e8967f",
+			DeviceType: "edge expander",
 		},
 	}
 
@@ -91,24 +105,36 @@ func TestSASEndDeviceClass(t *testing.T) {
 
 	want := SASDeviceClass{
 		"end_device-11:0:0": {
-			Name:         "end_device-11:0:0",
-			SASAddress:   "0x5000ccab02009402",
-			BlockDevices: []string{"sdv"},
+			Name:                "end_device-11:0:0",
+			SASAddress:          "0x5000ccab02009402",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "58",
+			BlockDevices:        []string{"sdv"},
 		},
 		"end_device-11:0:1": {
-			Name:         "end_device-11:0:1",
-			SASAddress:   "0x5000cca26128b1f5",
-			BlockDevices: []string{"sdw"},
+			Name:                "end_device-11:0:1",
+			SASAddress:          "0x5000cca26128b1f5",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "23",
+			BlockDevices:        []string{"sdw"},
 		},
 		"end_device-11:0:2": {
-			Name:         "end_device-11:0:2",
-			SASAddress:   "0x5000ccab02009406",
-			BlockDevices: []string{"sdx"},
+			Name:                "end_device-11:0:2",
+			SASAddress:          "0x5000ccab02009406",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x5000ccab020094ff",
+			BayIdentifier:       "57",
+			BlockDevices:        []string{"sdx"},
 		},
 		"end_device-11:2": {
-			Name:         "end_device-11:2",
-			SASAddress:   "0x5000cca0506b5f1d",
-			BlockDevices: []string{"sdp"},
+			Name:                "end_device-11:2",
+			SASAddress:          "0x5000cca0506b5f1d",
+			DeviceType:          "end device",
+			EnclosureIdentifier: "0x500062b2047b51c0",
+			BayIdentifier:       "0",
+			BlockDevices:        []string{"sdp"},
 		},
 	}
 
@@ -132,6 +158,7 @@ func TestSASExpanderClass(t *testing.T) {
 		"expander-11:0": {
 			Name:       "expander-11:0",
 			SASAddress: "0x5000ccab0200947e",
+			DeviceType: "edge expander",
 			SASPhys: []string{
 				"phy-11:0:10", "phy-11:0:11", "phy-11:0:12",
 				"phy-11:0:13", "phy-11:0:14", "phy-11:0:15",
@@ -146,6 +173,7 @@ func TestSASExpanderClass(t *testing.T) {
 		"expander-11:1": {
 			Name:       "expander-11:1",
 			SASAddress: "0x5003048001e8967f",
+			DeviceType: "edge expander",
 		},
 	}
 
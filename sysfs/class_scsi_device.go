@@ -0,0 +1,210 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	scsiDeviceClassPath = "class/scsi_device"
+	scsiHostClassPath   = "class/scsi_host"
+)
+
+// ScsiDevice contains info from files in /sys/class/scsi_device/<Name>/device
+// for a single SCSI logical unit.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-bus-scsi
+type ScsiDevice struct {
+	Name         string  // SCSI address as <host>:<channel>:<target>:<lun>
+	Vendor       *string // /sys/class/scsi_device/<Name>/device/vendor
+	Model        *string // /sys/class/scsi_device/<Name>/device/model
+	Rev          *string // /sys/class/scsi_device/<Name>/device/rev
+	State        *string // /sys/class/scsi_device/<Name>/device/state
+	Type         *uint64 // /sys/class/scsi_device/<Name>/device/type, SCSI peripheral device type
+	IORequestCnt *uint64 // /sys/class/scsi_device/<Name>/device/iorequest_cnt
+	IODoneCnt    *uint64 // /sys/class/scsi_device/<Name>/device/iodone_cnt
+	IOErrCnt     *uint64 // /sys/class/scsi_device/<Name>/device/ioerr_cnt
+	QueueDepth   *uint64 // /sys/class/scsi_device/<Name>/device/queue_depth
+	Timeout      *uint64 // /sys/class/scsi_device/<Name>/device/timeout, in seconds
+}
+
+// ScsiDevices is a collection of every SCSI device in /sys/class/scsi_device .
+//
+// The map keys are the SCSI addresses, like "0:0:0:0".
+type ScsiDevices map[string]ScsiDevice
+
+// ScsiHost contains info from files in /sys/class/scsi_host/<Name> for a
+// single SCSI host adapter.
+type ScsiHost struct {
+	Name        string
+	ProcName    *string // /sys/class/scsi_host/<Name>/proc_name, the driver name
+	State       *string // /sys/class/scsi_host/<Name>/state
+	ActiveMode  *string // /sys/class/scsi_host/<Name>/active_mode
+	UniqueID    *uint64 // /sys/class/scsi_host/<Name>/unique_id
+	CanQueue    *uint64 // /sys/class/scsi_host/<Name>/can_queue
+	CmdPerLun   *uint64 // /sys/class/scsi_host/<Name>/cmd_per_lun
+	HostBusy    *uint64 // /sys/class/scsi_host/<Name>/host_busy
+	SgTablesize *uint64 // /sys/class/scsi_host/<Name>/sg_tablesize
+}
+
+// ScsiHosts is a collection of every SCSI host in /sys/class/scsi_host .
+//
+// The map keys are the host names, like "host0".
+type ScsiHosts map[string]ScsiHost
+
+// ScsiDevices returns info for all SCSI devices read from
+// /sys/class/scsi_device .
+func (fs FS) ScsiDevices() (ScsiDevices, error) {
+	path := fs.sys.Path(scsiDeviceClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(ScsiDevices, len(dirs))
+	for _, d := range dirs {
+		device, err := fs.parseScsiDevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseScsiDevice(name string) (*ScsiDevice, error) {
+	path := fs.sys.Path(scsiDeviceClassPath, name, "device")
+	device := &ScsiDevice{Name: name}
+
+	for _, f := range [...]string{
+		"vendor", "model", "rev", "state", "type",
+		"iorequest_cnt", "iodone_cnt", "ioerr_cnt", "queue_depth", "timeout",
+	} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		// The I/O counters are printed in hexadecimal with a 0x prefix.
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "vendor":
+			device.Vendor = &value
+		case "model":
+			device.Model = &value
+		case "rev":
+			device.Rev = &value
+		case "state":
+			device.State = &value
+		case "type":
+			device.Type = vp.PUInt64()
+		case "iorequest_cnt":
+			device.IORequestCnt = vp.PUInt64()
+		case "iodone_cnt":
+			device.IODoneCnt = vp.PUInt64()
+		case "ioerr_cnt":
+			device.IOErrCnt = vp.PUInt64()
+		case "queue_depth":
+			device.QueueDepth = vp.PUInt64()
+		case "timeout":
+			device.Timeout = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return device, nil
+}
+
+// ScsiHosts returns info for all SCSI hosts read from /sys/class/scsi_host .
+func (fs FS) ScsiHosts() (ScsiHosts, error) {
+	path := fs.sys.Path(scsiHostClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := make(ScsiHosts, len(dirs))
+	for _, d := range dirs {
+		host, err := fs.parseScsiHost(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		hosts[host.Name] = *host
+	}
+
+	return hosts, nil
+}
+
+func (fs FS) parseScsiHost(name string) (*ScsiHost, error) {
+	path := fs.sys.Path(scsiHostClassPath, name)
+	host := &ScsiHost{Name: name}
+
+	for _, f := range [...]string{
+		"proc_name", "state", "active_mode", "unique_id",
+		"can_queue", "cmd_per_lun", "host_busy", "sg_tablesize",
+	} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "proc_name":
+			host.ProcName = &value
+		case "state":
+			host.State = &value
+		case "active_mode":
+			host.ActiveMode = &value
+		case "unique_id":
+			host.UniqueID = vp.PUInt64()
+		case "can_queue":
+			host.CanQueue = vp.PUInt64()
+		case "cmd_per_lun":
+			host.CmdPerLun = vp.PUInt64()
+		case "host_busy":
+			host.HostBusy = vp.PUInt64()
+		case "sg_tablesize":
+			host.SgTablesize = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return host, nil
+}
@@ -0,0 +1,108 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScsiDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.ScsiDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ScsiDevices{
+		"3:0:0:0": {
+			Name:         "3:0:0:0",
+			Vendor:       makeString("ATA"),
+			Model:        makeString("Samsung SSD 860"),
+			Rev:          makeString("3B6Q"),
+			State:        makeString("running"),
+			Type:         makeUint64(0),
+			IORequestCnt: makeUint64(0x4a3e2),
+			IODoneCnt:    makeUint64(0x4a3e2),
+			IOErrCnt:     makeUint64(0),
+			QueueDepth:   makeUint64(32),
+			Timeout:      makeUint64(30),
+		},
+		"4:0:0:0": {
+			Name:         "4:0:0:0",
+			Vendor:       makeString("ATA"),
+			Model:        makeString("ST4000DM004-2CV1"),
+			Rev:          makeString("0001"),
+			State:        makeString("offline"),
+			Type:         makeUint64(0),
+			IORequestCnt: makeUint64(0x1f05),
+			IODoneCnt:    makeUint64(0x1f00),
+			IOErrCnt:     makeUint64(0x1c),
+			QueueDepth:   makeUint64(1),
+			Timeout:      makeUint64(30),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected SCSI devices (-want +got):\n%s", diff)
+	}
+}
+
+func TestScsiHosts(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.ScsiHosts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ScsiHosts{
+		"host3": {
+			Name:        "host3",
+			ProcName:    makeString("ahci"),
+			State:       makeString("running"),
+			ActiveMode:  makeString("Initiator"),
+			UniqueID:    makeUint64(4),
+			CanQueue:    makeUint64(32),
+			CmdPerLun:   makeUint64(0),
+			HostBusy:    makeUint64(0),
+			SgTablesize: makeUint64(168),
+		},
+		"host4": {
+			Name:        "host4",
+			ProcName:    makeString("ahci"),
+			State:       makeString("running"),
+			ActiveMode:  makeString("Initiator"),
+			UniqueID:    makeUint64(5),
+			CanQueue:    makeUint64(32),
+			CmdPerLun:   makeUint64(0),
+			HostBusy:    makeUint64(1),
+			SgTablesize: makeUint64(168),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected SCSI hosts (-want +got):\n%s", diff)
+	}
+}
@@ -20,12 +20,15 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 
 	"github.com/prometheus/procfs/internal/util"
 )
 
 const scsiTapeClassPath = "class/scsi_tape"
 
+// SCSITapeCounters contains the I/O statistics of a SCSI tape device.
+// https://www.kernel.org/doc/Documentation/scsi/st.rst
 type SCSITapeCounters struct {
 	WriteNs      uint64 // /sys/class/scsi_tape/<Name>/stats/write_ns
 	ReadByteCnt  uint64 // /sys/class/scsi_tape/<Name>/stats/read_byte_cnt
@@ -39,11 +42,16 @@ type SCSITapeCounters struct {
 	WriteByteCnt uint64 // /sys/class/scsi_tape/<Name>/stats/write_byte_cnt
 }
 
+// SCSITape contains info for a single SCSI tape device.
 type SCSITape struct {
 	Name     string           // /sys/class/scsi_tape/<Name>
-	Counters SCSITapeCounters // /sys/class/scsi_tape/<Name>/statistics/*
+	Counters SCSITapeCounters // /sys/class/scsi_tape/<Name>/stats/*
 }
 
+// SCSITapeClass is a collection of every SCSI tape device in
+// /sys/class/scsi_tape .
+//
+// The map keys are the device names, like "st0".
 type SCSITapeClass map[string]SCSITape
 
 // SCSITapeClass parses st[0-9]+ devices in /sys/class/scsi_tape.
@@ -106,34 +114,33 @@ func parseSCSITapeStatistics(tapePath string) (*SCSITapeCounters, error) {
 			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
 		}
 
-		vp := util.NewValueParser(value)
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+
 		switch f.Name() {
 		case "in_flight":
-			counters.InFlight = *vp.PUInt64()
+			counters.InFlight = v
 		case "io_ns":
-			counters.IoNs = *vp.PUInt64()
+			counters.IoNs = v
 		case "other_cnt":
-			counters.OtherCnt = *vp.PUInt64()
+			counters.OtherCnt = v
 		case "read_byte_cnt":
-			counters.ReadByteCnt = *vp.PUInt64()
+			counters.ReadByteCnt = v
 		case "read_cnt":
-			counters.ReadCnt = *vp.PUInt64()
+			counters.ReadCnt = v
 		case "read_ns":
-			counters.ReadNs = *vp.PUInt64()
+			counters.ReadNs = v
 		case "resid_cnt":
-			counters.ResidCnt = *vp.PUInt64()
+			counters.ResidCnt = v
 		case "write_byte_cnt":
-			counters.WriteByteCnt = *vp.PUInt64()
+			counters.WriteByteCnt = v
 		case "write_cnt":
-			counters.WriteCnt = *vp.PUInt64()
+			counters.WriteCnt = v
 		case "write_ns":
-			counters.WriteNs = *vp.PUInt64()
+			counters.WriteNs = v
 		}
-
-		if err := vp.Err(); err != nil {
-			return nil, err
-		}
-
 	}
 
 	return &counters, nil
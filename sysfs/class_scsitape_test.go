@@ -16,6 +16,8 @@
 package sysfs
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -54,3 +56,23 @@ func TestSCSITapeClass(t *testing.T) {
 		t.Fatalf("unexpected SCSITape class (-want +got):\n%s", diff)
 	}
 }
+
+func TestSCSITapeClassMalformed(t *testing.T) {
+	dir := t.TempDir()
+	stats := filepath.Join(dir, "class/scsi_tape/st0/stats")
+	if err := os.MkdirAll(stats, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(stats, "read_cnt"), []byte("garbage\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fs.SCSITapeClass(); err == nil {
+		t.Fatal("expected error for malformed counter")
+	}
+}
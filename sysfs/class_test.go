@@ -0,0 +1,130 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClassDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.ClassDevices("watchdog")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := fs.sys.Path("class/watchdog")
+	want := []ClassDevice{
+		{Class: "watchdog", Name: "watchdog0", Path: filepath.Join(path, "watchdog0")},
+		{Class: "watchdog", Name: "watchdog1", Path: filepath.Join(path, "watchdog1")},
+	}
+	if diff := cmp.Diff(want, devices); diff != "" {
+		t.Fatalf("unexpected class devices (-want +got):\n%s", diff)
+	}
+
+	wd := devices[0]
+
+	identity, err := wd.ReadString("identity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Software Watchdog"; identity != want {
+		t.Errorf("want identity %q, got %q", want, identity)
+	}
+
+	timeout, err := wd.ReadUint("timeout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(60); timeout != want {
+		t.Errorf("want timeout %d, got %d", want, timeout)
+	}
+
+	nowayout, err := wd.ReadBool("nowayout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nowayout {
+		t.Errorf("want nowayout false, got true")
+	}
+
+	if _, err := wd.ReadUint("identity"); err == nil {
+		t.Error("expected error parsing non-numeric attribute")
+	}
+	if _, err := wd.ReadBool("timeout"); err == nil {
+		t.Error("expected error parsing non-boolean attribute")
+	}
+	if _, err := wd.ReadString("missing"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist for missing attribute, got %v", err)
+	}
+}
+
+func TestClassDeviceReadBool(t *testing.T) {
+	d := ClassDevice{Path: t.TempDir()}
+
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: "1", want: true},
+		{value: "Y", want: true},
+		{value: "yes", want: true},
+		{value: "t", want: true},
+		{value: "True", want: true},
+		{value: "on", want: true},
+		{value: "enabled", want: true},
+		{value: "0", want: false},
+		{value: "N", want: false},
+		{value: "f", want: false},
+		{value: "False", want: false},
+		{value: "off", want: false},
+		{value: "disabled", want: false},
+		{value: "o", wantErr: true},
+		{value: "60", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if err := os.WriteFile(filepath.Join(d.Path, "attr"), []byte(tt.value+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := d.ReadBool("attr")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %t", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("want %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
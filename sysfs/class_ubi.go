@@ -0,0 +1,201 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const ubiClassPath = "class/ubi"
+
+// UBIVolume contains info from files in /sys/class/ubi/ubi<N>_<M> for a
+// single UBI volume.
+type UBIVolume struct {
+	Name         string
+	VolumeName   *string // /sys/class/ubi/<Name>/name
+	Type         *string // /sys/class/ubi/<Name>/type, dynamic or static
+	ReservedEBs  *uint64 // /sys/class/ubi/<Name>/reserved_ebs
+	DataBytes    *uint64 // /sys/class/ubi/<Name>/data_bytes
+	UsableEBSize *uint64 // /sys/class/ubi/<Name>/usable_eb_size
+	Corrupted    *bool   // /sys/class/ubi/<Name>/corrupted
+	UpdateMarker *bool   // /sys/class/ubi/<Name>/upd_marker
+}
+
+// UBIDevice contains info from files in /sys/class/ubi/ubi<N> for a single
+// UBI device.
+// https://www.kernel.org/doc/Documentation/ABI/stable/sysfs-class-ubi
+type UBIDevice struct {
+	Name             string
+	MTDNum           *uint64 // /sys/class/ubi/<Name>/mtd_num
+	EraseblockSize   *uint64 // /sys/class/ubi/<Name>/eraseblock_size
+	TotalEraseblocks *uint64 // /sys/class/ubi/<Name>/total_eraseblocks
+	AvailEraseblocks *uint64 // /sys/class/ubi/<Name>/avail_eraseblocks
+	BadPEBCount      *uint64 // /sys/class/ubi/<Name>/bad_peb_count
+	ReservedForBad   *uint64 // /sys/class/ubi/<Name>/reserved_for_bad
+	MaxEC            *uint64 // /sys/class/ubi/<Name>/max_ec, highest erase counter
+	MeanEC           *uint64 // /sys/class/ubi/<Name>/mean_ec, mean erase counter
+	MinIOSize        *uint64 // /sys/class/ubi/<Name>/min_io_size
+	VolumesCount     *uint64 // /sys/class/ubi/<Name>/volumes_count
+	// Volumes holds the volumes of the device keyed by volume device name,
+	// like "ubi0_0".
+	Volumes map[string]UBIVolume
+}
+
+// UBIClass is a collection of every UBI device in /sys/class/ubi .
+//
+// The map keys are the device names, like "ubi0".
+type UBIClass map[string]UBIDevice
+
+// UBIClass returns info for all UBI devices and their volumes read from
+// /sys/class/ubi .
+func (fs FS) UBIClass() (UBIClass, error) {
+	path := fs.sys.Path(ubiClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list UBI devices at %q: %w", path, err)
+	}
+
+	devices := UBIClass{}
+	volumes := map[string]map[string]UBIVolume{}
+	for _, d := range dirs {
+		// Skip the ubi_ctrl control device.
+		if d.Name() == "ubi_ctrl" || !strings.HasPrefix(d.Name(), "ubi") {
+			continue
+		}
+
+		// Volumes are named ubi<device>_<volume>.
+		if id, _, ok := strings.Cut(strings.TrimPrefix(d.Name(), "ubi"), "_"); ok {
+			volume, err := fs.parseUBIVolume(d.Name())
+			if err != nil {
+				return nil, err
+			}
+			if volumes["ubi"+id] == nil {
+				volumes["ubi"+id] = map[string]UBIVolume{}
+			}
+			volumes["ubi"+id][volume.Name] = *volume
+			continue
+		}
+
+		device, err := fs.parseUBIDevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	for name, device := range devices {
+		device.Volumes = volumes[name]
+		devices[name] = device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseUBIDevice(name string) (*UBIDevice, error) {
+	path := fs.sys.Path(ubiClassPath, name)
+	device := &UBIDevice{Name: name}
+
+	for _, f := range [...]string{"mtd_num", "eraseblock_size", "total_eraseblocks", "avail_eraseblocks", "bad_peb_count", "reserved_for_bad", "max_ec", "mean_ec", "min_io_size", "volumes_count"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "mtd_num":
+			device.MTDNum = vp.PUInt64()
+		case "eraseblock_size":
+			device.EraseblockSize = vp.PUInt64()
+		case "total_eraseblocks":
+			device.TotalEraseblocks = vp.PUInt64()
+		case "avail_eraseblocks":
+			device.AvailEraseblocks = vp.PUInt64()
+		case "bad_peb_count":
+			device.BadPEBCount = vp.PUInt64()
+		case "reserved_for_bad":
+			device.ReservedForBad = vp.PUInt64()
+		case "max_ec":
+			device.MaxEC = vp.PUInt64()
+		case "mean_ec":
+			device.MeanEC = vp.PUInt64()
+		case "min_io_size":
+			device.MinIOSize = vp.PUInt64()
+		case "volumes_count":
+			device.VolumesCount = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return device, nil
+}
+
+func (fs FS) parseUBIVolume(name string) (*UBIVolume, error) {
+	path := fs.sys.Path(ubiClassPath, name)
+	volume := &UBIVolume{Name: name}
+
+	for _, f := range [...]string{"name", "type", "reserved_ebs", "data_bytes", "usable_eb_size", "corrupted", "upd_marker"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "name":
+			volume.VolumeName = &value
+		case "type":
+			volume.Type = &value
+		case "reserved_ebs":
+			volume.ReservedEBs = vp.PUInt64()
+		case "data_bytes":
+			volume.DataBytes = vp.PUInt64()
+		case "usable_eb_size":
+			volume.UsableEBSize = vp.PUInt64()
+		case "corrupted":
+			v := vp.Int() != 0
+			volume.Corrupted = &v
+		case "upd_marker":
+			v := vp.Int() != 0
+			volume.UpdateMarker = &v
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return volume, nil
+}
@@ -0,0 +1,76 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUBIClass(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.UBIClass()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := UBIClass{
+		"ubi0": {
+			Name:             "ubi0",
+			MTDNum:           makeUint64(0),
+			EraseblockSize:   makeUint64(126976),
+			TotalEraseblocks: makeUint64(2044),
+			AvailEraseblocks: makeUint64(12),
+			BadPEBCount:      makeUint64(3),
+			ReservedForBad:   makeUint64(37),
+			MaxEC:            makeUint64(412),
+			MeanEC:           makeUint64(96),
+			MinIOSize:        makeUint64(2048),
+			VolumesCount:     makeUint64(2),
+			Volumes: map[string]UBIVolume{
+				"ubi0_0": {
+					Name:         "ubi0_0",
+					VolumeName:   makeString("rootfs"),
+					Type:         makeString("dynamic"),
+					ReservedEBs:  makeUint64(1600),
+					DataBytes:    makeUint64(203161600),
+					UsableEBSize: makeUint64(126976),
+					Corrupted:    makeBool(false),
+					UpdateMarker: makeBool(false),
+				},
+				"ubi0_1": {
+					Name:         "ubi0_1",
+					VolumeName:   makeString("data"),
+					Type:         makeString("dynamic"),
+					ReservedEBs:  makeUint64(390),
+					DataBytes:    makeUint64(49520640),
+					UsableEBSize: makeUint64(126976),
+					Corrupted:    makeBool(false),
+					UpdateMarker: makeBool(true),
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected UBI devices (-want +got):\n%s", diff)
+	}
+}
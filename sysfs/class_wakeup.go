@@ -0,0 +1,180 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	wakeupClassPath   = "class/wakeup"
+	wakeupReasonsPath = "kernel/wakeup_reasons"
+)
+
+// WakeupSource contains info from files in /sys/class/wakeup for a single
+// wakeup source.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-wakeup
+type WakeupSource struct {
+	Name                 string
+	SourceName           *string // /sys/class/wakeup/<Name>/name
+	ActiveCount          *uint64 // /sys/class/wakeup/<Name>/active_count
+	EventCount           *uint64 // /sys/class/wakeup/<Name>/event_count
+	WakeupCount          *uint64 // /sys/class/wakeup/<Name>/wakeup_count
+	ExpireCount          *uint64 // /sys/class/wakeup/<Name>/expire_count
+	ActiveTimeMs         *uint64 // /sys/class/wakeup/<Name>/active_time_ms
+	TotalTimeMs          *uint64 // /sys/class/wakeup/<Name>/total_time_ms
+	MaxTimeMs            *uint64 // /sys/class/wakeup/<Name>/max_time_ms
+	LastChangeMs         *uint64 // /sys/class/wakeup/<Name>/last_change_ms
+	PreventSuspendTimeMs *uint64 // /sys/class/wakeup/<Name>/prevent_suspend_time_ms
+}
+
+// WakeupSources is a collection of every wakeup source in /sys/class/wakeup .
+//
+// The map keys are the wakeup device names, like "wakeup0".
+type WakeupSources map[string]WakeupSource
+
+// WakeupReasons contains info from files in /sys/kernel/wakeup_reasons ,
+// which is only provided by Android kernels.
+type WakeupReasons struct {
+	// LastResumeReasons are the reasons for the last resume, one per line,
+	// like "170 mpm" for an interrupt.
+	// /sys/kernel/wakeup_reasons/last_resume_reason
+	LastResumeReasons []string
+	// LastSuspendTime and LastSleepTime are the seconds spent entering and
+	// exiting the last suspend, and in suspend.
+	// /sys/kernel/wakeup_reasons/last_suspend_time
+	LastSuspendTime *float64
+	LastSleepTime   *float64
+}
+
+// WakeupSources returns info for all wakeup sources read from
+// /sys/class/wakeup .
+func (fs FS) WakeupSources() (WakeupSources, error) {
+	path := fs.sys.Path(wakeupClassPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wakeup sources at %q: %w", path, err)
+	}
+
+	sources := make(WakeupSources, len(dirs))
+	for _, d := range dirs {
+		source, err := fs.parseWakeupSource(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		sources[source.Name] = *source
+	}
+
+	return sources, nil
+}
+
+func (fs FS) parseWakeupSource(name string) (*WakeupSource, error) {
+	path := fs.sys.Path(wakeupClassPath, name)
+	source := &WakeupSource{Name: name}
+
+	for _, f := range [...]string{"name", "active_count", "event_count", "wakeup_count", "expire_count", "active_time_ms", "total_time_ms", "max_time_ms", "last_change_ms", "prevent_suspend_time_ms"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "name":
+			source.SourceName = &value
+		case "active_count":
+			source.ActiveCount = vp.PUInt64()
+		case "event_count":
+			source.EventCount = vp.PUInt64()
+		case "wakeup_count":
+			source.WakeupCount = vp.PUInt64()
+		case "expire_count":
+			source.ExpireCount = vp.PUInt64()
+		case "active_time_ms":
+			source.ActiveTimeMs = vp.PUInt64()
+		case "total_time_ms":
+			source.TotalTimeMs = vp.PUInt64()
+		case "max_time_ms":
+			source.MaxTimeMs = vp.PUInt64()
+		case "last_change_ms":
+			source.LastChangeMs = vp.PUInt64()
+		case "prevent_suspend_time_ms":
+			source.PreventSuspendTimeMs = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return source, nil
+}
+
+// WakeupReasons returns the reasons for the last resume read from
+// /sys/kernel/wakeup_reasons .
+func (fs FS) WakeupReasons() (*WakeupReasons, error) {
+	path := fs.sys.Path(wakeupReasonsPath)
+	reasons := &WakeupReasons{}
+
+	file := filepath.Join(path, "last_resume_reason")
+	data, err := util.ReadFileNoStat(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			reasons.LastResumeReasons = append(reasons.LastResumeReasons, line)
+		}
+	}
+
+	file = filepath.Join(path, "last_suspend_time")
+	value, err := util.SysReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return reasons, nil
+		}
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("failed to parse %q: unexpected value %q", file, value)
+	}
+	suspend, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+	}
+	sleep, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+	}
+	reasons.LastSuspendTime = &suspend
+	reasons.LastSleepTime = &sleep
+
+	return reasons, nil
+}
@@ -0,0 +1,94 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWakeupSources(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.WakeupSources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := WakeupSources{
+		"wakeup0": {
+			Name:                 "wakeup0",
+			SourceName:           makeString("PNP0C0C:00"),
+			ActiveCount:          makeUint64(12),
+			EventCount:           makeUint64(12),
+			WakeupCount:          makeUint64(3),
+			ExpireCount:          makeUint64(0),
+			ActiveTimeMs:         makeUint64(0),
+			TotalTimeMs:          makeUint64(41),
+			MaxTimeMs:            makeUint64(9),
+			LastChangeMs:         makeUint64(1822043),
+			PreventSuspendTimeMs: makeUint64(0),
+		},
+		"wakeup1": {
+			Name:                 "wakeup1",
+			SourceName:           makeString("alarmtimer.0.auto"),
+			ActiveCount:          makeUint64(0),
+			EventCount:           makeUint64(0),
+			WakeupCount:          makeUint64(0),
+			ExpireCount:          makeUint64(0),
+			ActiveTimeMs:         makeUint64(0),
+			TotalTimeMs:          makeUint64(0),
+			MaxTimeMs:            makeUint64(0),
+			LastChangeMs:         makeUint64(0),
+			PreventSuspendTimeMs: makeUint64(0),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected wakeup sources (-want +got):\n%s", diff)
+	}
+}
+
+func TestWakeupReasons(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.WakeupReasons()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suspend, sleep := 0.015625, 3.221344
+
+	want := &WakeupReasons{
+		LastResumeReasons: []string{
+			"170 mpm",
+			"Abort: Pending Wakeup Sources: ipc00000177_FLP Service_C",
+		},
+		LastSuspendTime: &suspend,
+		LastSleepTime:   &sleep,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected wakeup reasons (-want +got):\n%s", diff)
+	}
+}
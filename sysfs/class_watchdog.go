@@ -25,6 +25,22 @@ import (
 
 const watchdogClassPath = "class/watchdog"
 
+// watchdogBootstatusFlags are the WDIOF_* bits reported in bootstatus, see
+// include/uapi/linux/watchdog.h .
+var watchdogBootstatusFlags = []struct {
+	bit  int64
+	name string
+}{
+	{0x0001, "overheat"},
+	{0x0002, "fanfault"},
+	{0x0004, "extern1"},
+	{0x0008, "extern2"},
+	{0x0010, "powerunder"},
+	{0x0020, "cardreset"},
+	{0x0040, "powerover"},
+	{0x8000, "keepaliveping"},
+}
+
 // WatchdogStats contains info from files in /sys/class/watchdog for a single watchdog device.
 // https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-watchdog
 type WatchdogStats struct {
@@ -120,3 +136,20 @@ func (fs FS) parseWatchdog(wdName string) (*WatchdogStats, error) {
 
 	return &wd, nil
 }
+
+// BootstatusFlags returns the names of the WDIOF_* flags set in Bootstatus,
+// e.g. "cardreset" if the last reboot was caused by the watchdog. Unknown
+// bits are ignored.
+func (wd WatchdogStats) BootstatusFlags() []string {
+	if wd.Bootstatus == nil {
+		return nil
+	}
+
+	var flags []string
+	for _, f := range watchdogBootstatusFlags {
+		if *wd.Bootstatus&f.bit != 0 {
+			flags = append(flags, f.name)
+		}
+	}
+	return flags
+}
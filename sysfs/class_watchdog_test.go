@@ -73,3 +73,24 @@ func TestWatchdogClass(t *testing.T) {
 		t.Fatalf("unexpected watchdog class (-want +got):\n%s", diff)
 	}
 }
+
+func TestWatchdogBootstatusFlags(t *testing.T) {
+
+	tests := []struct {
+		bootstatus *int64
+		want       []string
+	}{
+		{nil, nil},
+		{makeInt64(0), nil},
+		{makeInt64(0x20), []string{"cardreset"}},
+		{makeInt64(0x8021), []string{"overheat", "cardreset", "keepaliveping"}},
+		{makeInt64(0x100), nil},
+	}
+
+	for _, tt := range tests {
+		got := WatchdogStats{Bootstatus: tt.bootstatus}.BootstatusFlags()
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("unexpected bootstatus flags (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const cxlDevicesPath = "bus/cxl/devices"
+
+// CxlMemdev contains info from files in /sys/bus/cxl/devices/mem<N> for a
+// single CXL memory device.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-bus-cxl
+type CxlMemdev struct {
+	Name            string
+	Serial          *uint64            // /sys/bus/cxl/devices/<Name>/serial
+	FirmwareVersion *string            // /sys/bus/cxl/devices/<Name>/firmware_version
+	NumaNode        *int64             // /sys/bus/cxl/devices/<Name>/numa_node
+	PayloadMax      *uint64            // /sys/bus/cxl/devices/<Name>/payload_max
+	RAMSize         *uint64            // /sys/bus/cxl/devices/<Name>/ram/size
+	PMEMSize        *uint64            // /sys/bus/cxl/devices/<Name>/pmem/size
+	PciLocation     *PciDeviceLocation // PCI device the memdev is attached to
+}
+
+// CxlDecoder contains info from files in /sys/bus/cxl/devices/decoder<X>.<Y>
+// for a single CXL HDM decoder.
+type CxlDecoder struct {
+	Name                  string
+	Start                 *uint64 // /sys/bus/cxl/devices/<Name>/start
+	Size                  *uint64 // /sys/bus/cxl/devices/<Name>/size
+	InterleaveWays        *uint64 // /sys/bus/cxl/devices/<Name>/interleave_ways
+	InterleaveGranularity *uint64 // /sys/bus/cxl/devices/<Name>/interleave_granularity
+	TargetType            *string // /sys/bus/cxl/devices/<Name>/target_type
+	Mode                  *string // /sys/bus/cxl/devices/<Name>/mode, endpoint decoders only
+	DPASize               *uint64 // /sys/bus/cxl/devices/<Name>/dpa_size, endpoint decoders only
+	Locked                *bool   // /sys/bus/cxl/devices/<Name>/locked
+}
+
+// CxlRegion contains info from files in /sys/bus/cxl/devices/region<N> for a
+// single CXL memory region.
+type CxlRegion struct {
+	Name                  string
+	UUID                  *string  // /sys/bus/cxl/devices/<Name>/uuid
+	Size                  *uint64  // /sys/bus/cxl/devices/<Name>/size
+	Resource              *uint64  // /sys/bus/cxl/devices/<Name>/resource
+	InterleaveWays        *uint64  // /sys/bus/cxl/devices/<Name>/interleave_ways
+	InterleaveGranularity *uint64  // /sys/bus/cxl/devices/<Name>/interleave_granularity
+	Mode                  *string  // /sys/bus/cxl/devices/<Name>/mode
+	Commit                *bool    // /sys/bus/cxl/devices/<Name>/commit
+	Targets               []string // /sys/bus/cxl/devices/<Name>/target<N>
+}
+
+// CxlDevices contains the memory devices, decoders and regions found in
+// /sys/bus/cxl/devices . Ports and other devices are skipped.
+//
+// The map keys are the device names.
+type CxlDevices struct {
+	Memdevs  map[string]CxlMemdev
+	Decoders map[string]CxlDecoder
+	Regions  map[string]CxlRegion
+}
+
+// CxlDevices returns info for all CXL memory devices, decoders and regions
+// read from /sys/bus/cxl/devices .
+func (fs FS) CxlDevices() (*CxlDevices, error) {
+	path := fs.sys.Path(cxlDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := &CxlDevices{
+		Memdevs:  map[string]CxlMemdev{},
+		Decoders: map[string]CxlDecoder{},
+		Regions:  map[string]CxlRegion{},
+	}
+	for _, d := range dirs {
+		name := d.Name()
+		switch {
+		case strings.HasPrefix(name, "mem"):
+			memdev, err := fs.parseCxlMemdev(name)
+			if err != nil {
+				return nil, err
+			}
+			devices.Memdevs[name] = *memdev
+		case strings.HasPrefix(name, "decoder"):
+			decoder, err := fs.parseCxlDecoder(name)
+			if err != nil {
+				return nil, err
+			}
+			devices.Decoders[name] = *decoder
+		case strings.HasPrefix(name, "region"):
+			region, err := fs.parseCxlRegion(name)
+			if err != nil {
+				return nil, err
+			}
+			devices.Regions[name] = *region
+		}
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseCxlMemdev(name string) (*CxlMemdev, error) {
+	path := fs.sys.Path(cxlDevicesPath, name)
+	memdev := &CxlMemdev{Name: name}
+
+	for _, f := range [...]string{"serial", "firmware_version", "numa_node", "payload_max", "ram/size", "pmem/size"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "serial":
+			memdev.Serial = vp.PUInt64()
+		case "firmware_version":
+			memdev.FirmwareVersion = &value
+		case "numa_node":
+			memdev.NumaNode = vp.PInt64()
+		case "payload_max":
+			memdev.PayloadMax = vp.PUInt64()
+		case "ram/size":
+			memdev.RAMSize = vp.PUInt64()
+		case "pmem/size":
+			memdev.PMEMSize = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	// The memdev is a child of the PCI device implementing it, like
+	// "../../../devices/pci0000:35/0000:35:00.0/mem0".
+	target, err := os.Readlink(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to readlink %q: %w", path, err)
+	}
+	if location, err := ParsePciDeviceLocation(filepath.Base(filepath.Dir(target))); err == nil {
+		memdev.PciLocation = location
+	}
+
+	return memdev, nil
+}
+
+func (fs FS) parseCxlDecoder(name string) (*CxlDecoder, error) {
+	path := fs.sys.Path(cxlDevicesPath, name)
+	decoder := &CxlDecoder{Name: name}
+
+	for _, f := range [...]string{"start", "size", "interleave_ways", "interleave_granularity", "target_type", "mode", "dpa_size", "locked"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "start":
+			decoder.Start = vp.PUInt64()
+		case "size":
+			decoder.Size = vp.PUInt64()
+		case "interleave_ways":
+			decoder.InterleaveWays = vp.PUInt64()
+		case "interleave_granularity":
+			decoder.InterleaveGranularity = vp.PUInt64()
+		case "target_type":
+			decoder.TargetType = &value
+		case "mode":
+			decoder.Mode = &value
+		case "dpa_size":
+			decoder.DPASize = vp.PUInt64()
+		case "locked":
+			v := vp.Int() != 0
+			decoder.Locked = &v
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return decoder, nil
+}
+
+func (fs FS) parseCxlRegion(name string) (*CxlRegion, error) {
+	path := fs.sys.Path(cxlDevicesPath, name)
+	region := &CxlRegion{Name: name}
+
+	for _, f := range [...]string{"uuid", "size", "resource", "interleave_ways", "interleave_granularity", "mode", "commit"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "uuid":
+			region.UUID = &value
+		case "size":
+			region.Size = vp.PUInt64()
+		case "resource":
+			region.Resource = vp.PUInt64()
+		case "interleave_ways":
+			region.InterleaveWays = vp.PUInt64()
+		case "interleave_granularity":
+			region.InterleaveGranularity = vp.PUInt64()
+		case "mode":
+			region.Mode = &value
+		case "commit":
+			v := vp.Int() != 0
+			region.Commit = &v
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	// Each target<N> file names the endpoint decoder at interleave
+	// position N; unassigned positions are empty.
+	if region.InterleaveWays != nil {
+		for i := range *region.InterleaveWays {
+			file := filepath.Join(path, fmt.Sprintf("target%d", i))
+			value, err := util.SysReadFile(file)
+			if err != nil {
+				if os.IsNotExist(err) {
+					break
+				}
+				return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+			region.Targets = append(region.Targets, value)
+		}
+	}
+
+	return region, nil
+}
@@ -0,0 +1,114 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCxlDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.CxlDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		serial          uint64 = 0x4d2
+		firmwareVersion        = "BWFW VERSION 00"
+		numaNode        int64
+		payloadMax      uint64 = 4096
+		ramSize         uint64 = 0x40000000
+		pmemSize        uint64
+
+		start       uint64 = 0x4000000000
+		size        uint64 = 0x40000000
+		ways        uint64 = 1
+		granularity uint64 = 256
+		expander           = "expander"
+		modeRAM            = "ram"
+		locked             = true
+		unlocked           = false
+
+		uuid      = "2c8a7e7b-1f5c-4c6e-9f4d-6b1c2e3a4d5f"
+		committed = true
+	)
+
+	want := &CxlDevices{
+		Memdevs: map[string]CxlMemdev{
+			"mem0": {
+				Name:            "mem0",
+				Serial:          &serial,
+				FirmwareVersion: &firmwareVersion,
+				NumaNode:        &numaNode,
+				PayloadMax:      &payloadMax,
+				RAMSize:         &ramSize,
+				PMEMSize:        &pmemSize,
+				PciLocation: &PciDeviceLocation{
+					Segment:  0,
+					Bus:      0x35,
+					Device:   0,
+					Function: 0,
+				},
+			},
+		},
+		Decoders: map[string]CxlDecoder{
+			"decoder0.0": {
+				Name:                  "decoder0.0",
+				Start:                 &start,
+				Size:                  &size,
+				InterleaveWays:        &ways,
+				InterleaveGranularity: &granularity,
+				TargetType:            &expander,
+				Locked:                &unlocked,
+			},
+			"decoder2.0": {
+				Name:                  "decoder2.0",
+				Start:                 &start,
+				Size:                  &size,
+				InterleaveWays:        &ways,
+				InterleaveGranularity: &granularity,
+				TargetType:            &expander,
+				Mode:                  &modeRAM,
+				DPASize:               &size,
+				Locked:                &locked,
+			},
+		},
+		Regions: map[string]CxlRegion{
+			"region0": {
+				Name:                  "region0",
+				UUID:                  &uuid,
+				Size:                  &size,
+				Resource:              &start,
+				InterleaveWays:        &ways,
+				InterleaveGranularity: &granularity,
+				Mode:                  &modeRAM,
+				Commit:                &committed,
+				Targets:               []string{"decoder2.0"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected CXL devices (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const acpiPath = "firmware/acpi"
+
+// acpiPMProfiles are the preferred power management profiles of the FADT,
+// see the ACPI specification section 5.2.9 .
+var acpiPMProfiles = []string{
+	"Unspecified",
+	"Desktop",
+	"Mobile",
+	"Workstation",
+	"Enterprise Server",
+	"SOHO Server",
+	"Appliance PC",
+	"Performance Server",
+	"Tablet",
+}
+
+// ACPIInfo contains info from files in /sys/firmware/acpi .
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-firmware-acpi
+type ACPIInfo struct {
+	PMProfile       *uint64 // /sys/firmware/acpi/pm_profile
+	PlatformProfile *string // /sys/firmware/acpi/platform_profile, e.g. balanced
+	// PlatformProfileChoices are the profiles PlatformProfile can be set to.
+	// /sys/firmware/acpi/platform_profile_choices
+	PlatformProfileChoices []string
+	// Tables are the signatures of the ACPI tables, like "DSDT" or "SSDT1".
+	// /sys/firmware/acpi/tables
+	Tables []string
+}
+
+// ACPIInfo returns the ACPI platform attributes read from
+// /sys/firmware/acpi .
+func (fs FS) ACPIInfo() (*ACPIInfo, error) {
+	path := fs.sys.Path(acpiPath)
+	info := &ACPIInfo{}
+
+	for _, f := range [...]string{"pm_profile", "platform_profile", "platform_profile_choices"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "pm_profile":
+			info.PMProfile = vp.PUInt64()
+		case "platform_profile":
+			info.PlatformProfile = &value
+		case "platform_profile_choices":
+			info.PlatformProfileChoices = strings.Fields(value)
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	tablesPath := filepath.Join(path, "tables")
+	tables, err := os.ReadDir(tablesPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to list ACPI tables at %q: %w", tablesPath, err)
+	}
+	for _, t := range tables {
+		// Skip the data and dynamic subdirectories.
+		if t.IsDir() {
+			continue
+		}
+		info.Tables = append(info.Tables, t.Name())
+	}
+
+	return info, nil
+}
+
+// PMProfileName returns the name of the preferred power management profile,
+// e.g. "Mobile". It is empty if the profile is unknown.
+func (a ACPIInfo) PMProfileName() string {
+	if a.PMProfile == nil || *a.PMProfile >= uint64(len(acpiPMProfiles)) {
+		return ""
+	}
+	return acpiPMProfiles[*a.PMProfile]
+}
+
+// SetPlatformProfile selects the platform power profile by writing to
+// /sys/firmware/acpi/platform_profile . The profile must be one of
+// PlatformProfileChoices. The FS must have been created with NewWritableFS.
+func (fs FS) SetPlatformProfile(profile string) error {
+	return fs.writeFile(fs.sys.Path(acpiPath, "platform_profile"), profile)
+}
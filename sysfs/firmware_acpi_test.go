@@ -0,0 +1,96 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestACPIInfo(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.ACPIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		pmProfile       = uint64(2)
+		platformProfile = "balanced"
+	)
+
+	want := &ACPIInfo{
+		PMProfile:              &pmProfile,
+		PlatformProfile:        &platformProfile,
+		PlatformProfileChoices: []string{"low-power", "balanced", "performance"},
+		Tables:                 []string{"APIC", "DSDT", "FACP", "FACS", "HPET", "MCFG", "SSDT1", "SSDT2"},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected ACPI info (-want +got):\n%s", diff)
+	}
+
+	if name := got.PMProfileName(); name != "Mobile" {
+		t.Errorf("want PM profile name %q, got %q", "Mobile", name)
+	}
+	if name := (ACPIInfo{}).PMProfileName(); name != "" {
+		t.Errorf("want empty PM profile name, got %q", name)
+	}
+}
+
+func TestSetPlatformProfile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	path := filepath.Join(tempDir, acpiPath)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "platform_profile"), []byte("balanced\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	roFS, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := roFS.SetPlatformProfile("performance"); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("expected ErrReadOnlyFS setting platform profile, got %v", err)
+	}
+
+	fs, err := NewWritableFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.SetPlatformProfile("performance"); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := fs.ACPIInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.PlatformProfile == nil || *info.PlatformProfile != "performance" {
+		t.Errorf("unexpected platform profile: %v", info.PlatformProfile)
+	}
+}
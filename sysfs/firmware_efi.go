@@ -0,0 +1,152 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	efiPath        = "firmware/efi"
+	efiVarsPath    = "firmware/efi/efivars"
+	efiGlobalGUID  = "8be4df61-93ca-11d2-aa0d-00e098032b8c"
+	efiGUIDLength  = len(efiGlobalGUID)
+	efiAttrsLength = 4
+)
+
+// EfiVar contains the metadata of a single EFI variable in
+// /sys/firmware/efi/efivars . The files are named <Name>-<GUID> and start
+// with the 32-bit variable attributes followed by the data.
+// https://www.kernel.org/doc/Documentation/filesystems/efivarfs.rst
+type EfiVar struct {
+	Name string
+	GUID string
+	// Attributes holds the EFI_VARIABLE_* attribute bits, nil if the
+	// variable is not readable.
+	Attributes *uint32
+	// Size is the size of the variable data in bytes.
+	Size int64
+}
+
+// EfiVars is a collection of every EFI variable in /sys/firmware/efi/efivars .
+//
+// The map keys are the file names, like
+// "SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c".
+type EfiVars map[string]EfiVar
+
+// EfiFirmware contains the EFI platform info and the decoded secure boot
+// state.
+type EfiFirmware struct {
+	PlatformSize *uint64 // /sys/firmware/efi/fw_platform_size, 32 or 64
+	SecureBoot   *bool   // SecureBoot EFI variable
+	SetupMode    *bool   // SetupMode EFI variable
+}
+
+// EfiVars returns the metadata of all EFI variables read from
+// /sys/firmware/efi/efivars . The efivarfs filesystem must be mounted there.
+func (fs FS) EfiVars() (EfiVars, error) {
+	path := fs.sys.Path(efiVarsPath)
+
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list EFI variables at %q: %w", path, err)
+	}
+
+	vars := make(EfiVars, len(files))
+	for _, f := range files {
+		// Names end in -<GUID>, and the GUID itself contains dashes.
+		if len(f.Name()) <= efiGUIDLength+1 || f.Name()[len(f.Name())-efiGUIDLength-1] != '-' {
+			continue
+		}
+
+		v := EfiVar{
+			Name: f.Name()[:len(f.Name())-efiGUIDLength-1],
+			GUID: f.Name()[len(f.Name())-efiGUIDLength:],
+		}
+
+		file := filepath.Join(path, f.Name())
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if !os.IsPermission(err) {
+				return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+			// The size is still known from the file, which includes the
+			// attributes.
+			info, err := f.Info()
+			if err != nil {
+				return nil, fmt.Errorf("failed to stat %q: %w", file, err)
+			}
+			v.Size = max(info.Size()-efiAttrsLength, 0)
+		} else {
+			if len(data) < efiAttrsLength {
+				return nil, fmt.Errorf("failed to parse %q: variable too short", file)
+			}
+			attrs := binary.LittleEndian.Uint32(data[:efiAttrsLength])
+			v.Attributes = &attrs
+			v.Size = int64(len(data) - efiAttrsLength)
+		}
+
+		vars[f.Name()] = v
+	}
+
+	return vars, nil
+}
+
+// EfiFirmware returns the EFI platform size read from /sys/firmware/efi and
+// the secure boot state decoded from the SecureBoot and SetupMode variables.
+func (fs FS) EfiFirmware() (*EfiFirmware, error) {
+	firmware := &EfiFirmware{}
+
+	file := fs.sys.Path(efiPath, "fw_platform_size")
+	size, err := util.ReadUintFromFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+	} else {
+		firmware.PlatformSize = &size
+	}
+
+	for _, name := range [...]string{"SecureBoot", "SetupMode"} {
+		file := fs.sys.Path(efiVarsPath, name+"-"+efiGlobalGUID)
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		// Both variables hold a single byte after the attributes.
+		if len(data) != efiAttrsLength+1 {
+			return nil, fmt.Errorf("failed to parse %q: unexpected size %d", file, len(data))
+		}
+
+		v := data[efiAttrsLength] == 1
+		switch name {
+		case "SecureBoot":
+			firmware.SecureBoot = &v
+		case "SetupMode":
+			firmware.SetupMode = &v
+		}
+	}
+
+	return firmware, nil
+}
@@ -0,0 +1,119 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeMockEfivars(t *testing.T, dir string, vars map[string][]byte) {
+	t.Helper()
+
+	path := filepath.Join(dir, efiVarsPath)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range vars {
+		if err := os.WriteFile(filepath.Join(path, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestEfiVars(t *testing.T) {
+	tempDir := t.TempDir()
+	writeMockEfivars(t, tempDir, map[string][]byte{
+		"SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c": {0x06, 0, 0, 0, 0x01},
+		"BootOrder-8be4df61-93ca-11d2-aa0d-00e098032b8c":  {0x07, 0, 0, 0, 0x01, 0, 0x00, 0},
+		"MokListRT-605dab50-e046-4300-abb6-3dd810dd8b23":  {0x06, 0, 0, 0},
+	})
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.EfiVars()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := EfiVars{
+		"SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c": {
+			Name:       "SecureBoot",
+			GUID:       "8be4df61-93ca-11d2-aa0d-00e098032b8c",
+			Attributes: makeUint32(0x06),
+			Size:       1,
+		},
+		"BootOrder-8be4df61-93ca-11d2-aa0d-00e098032b8c": {
+			Name:       "BootOrder",
+			GUID:       "8be4df61-93ca-11d2-aa0d-00e098032b8c",
+			Attributes: makeUint32(0x07),
+			Size:       4,
+		},
+		"MokListRT-605dab50-e046-4300-abb6-3dd810dd8b23": {
+			Name:       "MokListRT",
+			GUID:       "605dab50-e046-4300-abb6-3dd810dd8b23",
+			Attributes: makeUint32(0x06),
+			Size:       0,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected EFI variables (-want +got):\n%s", diff)
+	}
+}
+
+func TestEfiFirmware(t *testing.T) {
+	tempDir := t.TempDir()
+	writeMockEfivars(t, tempDir, map[string][]byte{
+		"SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c": {0x06, 0, 0, 0, 0x01},
+		"SetupMode-8be4df61-93ca-11d2-aa0d-00e098032b8c":  {0x06, 0, 0, 0, 0x00},
+	})
+	if err := os.WriteFile(filepath.Join(tempDir, efiPath, "fw_platform_size"), []byte("64\n"), 0o444); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.EfiFirmware()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		platformSize = uint64(64)
+		secureBoot   = true
+		setupMode    = false
+	)
+
+	want := &EfiFirmware{
+		PlatformSize: &platformSize,
+		SecureBoot:   &secureBoot,
+		SetupMode:    &setupMode,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected EFI firmware info (-want +got):\n%s", diff)
+	}
+}
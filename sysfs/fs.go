@@ -16,15 +16,25 @@
 package sysfs
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
 	"github.com/prometheus/procfs/internal/fs"
 )
 
 // FS represents the pseudo-filesystem sys, which provides an interface to
 // kernel data structures.
 type FS struct {
-	sys fs.FS
+	sys      fs.FS
+	writable bool
 }
 
+// ErrReadOnlyFS is returned by methods that modify sysfs, such as removing a
+// PCI device, when the FS was not created with NewWritableFS. The SR-IOV
+// setters of PciDevice are the exception and write with any FS.
+var ErrReadOnlyFS = errors.New("sysfs: filesystem is not writable")
+
 // DefaultMountPoint is the common mount point of the sys filesystem.
 const DefaultMountPoint = fs.DefaultSysMountPoint
 
@@ -41,5 +51,47 @@ func NewFS(mountPoint string) (FS, error) {
 	if err != nil {
 		return FS{}, err
 	}
-	return FS{fs}, nil
+	return FS{sys: fs}, nil
+}
+
+// NewWritableFS returns a new FS mounted under the given mountPoint which,
+// unlike one returned by NewFS, also permits methods that write to sysfs,
+// such as removing or rescanning PCI devices. It will error if the mount
+// point can't be read.
+func NewWritableFS(mountPoint string) (FS, error) {
+	fs, err := NewFS(mountPoint)
+	if err != nil {
+		return FS{}, err
+	}
+	fs.writable = true
+	return fs, nil
+}
+
+// writeFile writes value to an existing sysfs attribute file. It fails with
+// ErrReadOnlyFS unless the FS was created with NewWritableFS.
+func (fs FS) writeFile(path, value string) error {
+	if !fs.writable {
+		return fmt.Errorf("failed to write file %q: %w", path, ErrReadOnlyFS)
+	}
+	return writeAttribute(path, value)
+}
+
+// writeAttribute writes value to an existing sysfs attribute file. It never
+// creates the file.
+func writeAttribute(path, value string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open file %q: %w", path, err)
+	}
+
+	if _, err := f.WriteString(value); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %q to file %q: %w", value, path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %q to file %q: %w", value, path, err)
+	}
+
+	return nil
 }
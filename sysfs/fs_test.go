@@ -21,6 +21,30 @@ const (
 	sysTestFixtures = "testdata/fixtures/sys"
 )
 
+func makeString(v string) *string {
+	return &v
+}
+
+func makeBool(v bool) *bool {
+	return &v
+}
+
+func makeInt64(v int64) *int64 {
+	return &v
+}
+
+func makeUint32(v uint32) *uint32 {
+	return &v
+}
+
+func makeUint64(v uint64) *uint64 {
+	return &v
+}
+
+func makeFloat64(v float64) *float64 {
+	return &v
+}
+
 func TestNewFS(t *testing.T) {
 	if _, err := NewFS("foobar"); err == nil {
 		t.Error("want NewFS to fail for non-existing mount point")
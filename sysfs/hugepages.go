@@ -0,0 +1,123 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const hugepagesPath = "kernel/mm/hugepages"
+
+// HugepagePool contains the counters of the hugepage pool of a single page
+// size from files in /sys/kernel/mm/hugepages/hugepages-<Size>kB .
+// https://www.kernel.org/doc/Documentation/admin-guide/mm/hugetlbpage.rst
+type HugepagePool struct {
+	Total      uint64 // nr_hugepages
+	Free       uint64 // free_hugepages
+	Reserved   uint64 // resv_hugepages
+	Surplus    uint64 // surplus_hugepages
+	Overcommit uint64 // nr_overcommit_hugepages
+	// Nodes holds the share of the pool on each NUMA node keyed by node ID.
+	// /sys/devices/system/node/node<ID>/hugepages/hugepages-<Size>kB
+	Nodes map[int]NumaNodeHugepages
+}
+
+// Hugepages is a collection of every hugepage pool in
+// /sys/kernel/mm/hugepages .
+//
+// The map keys are the page sizes in bytes.
+type Hugepages map[uint64]HugepagePool
+
+// Hugepages returns the hugepage pools read from /sys/kernel/mm/hugepages
+// along with their per-NUMA-node counters.
+func (fs FS) Hugepages() (Hugepages, error) {
+	path := fs.sys.Path(hugepagesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hugepages at %q: %w", path, err)
+	}
+
+	hugepages := make(Hugepages, len(dirs))
+	for _, d := range dirs {
+		sizeStr, ok := strings.CutPrefix(d.Name(), "hugepages-")
+		if !ok {
+			continue
+		}
+		size, err := strconv.ParseUint(strings.TrimSuffix(sizeStr, "kB"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse hugepage size %q: %w", d.Name(), err)
+		}
+
+		var pool HugepagePool
+		for _, f := range [...]string{"nr_hugepages", "free_hugepages", "resv_hugepages", "surplus_hugepages", "nr_overcommit_hugepages"} {
+			name := filepath.Join(path, d.Name(), f)
+			value, err := util.ReadUintFromFile(name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+			}
+
+			switch f {
+			case "nr_hugepages":
+				pool.Total = value
+			case "free_hugepages":
+				pool.Free = value
+			case "resv_hugepages":
+				pool.Reserved = value
+			case "surplus_hugepages":
+				pool.Surplus = value
+			case "nr_overcommit_hugepages":
+				pool.Overcommit = value
+			}
+		}
+		hugepages[size*1024] = pool
+	}
+
+	nodes, err := filepath.Glob(fs.sys.Path(nodePattern))
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(node), "node"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NUMA node %q: %w", node, err)
+		}
+
+		nodePools, err := parseNumaNodeHugepages(filepath.Join(node, "hugepages"))
+		if err != nil {
+			return nil, err
+		}
+		for size, nodePool := range nodePools {
+			pool, ok := hugepages[size]
+			if !ok {
+				continue
+			}
+			if pool.Nodes == nil {
+				pool.Nodes = map[int]NumaNodeHugepages{}
+			}
+			pool.Nodes[id] = nodePool
+			hugepages[size] = pool
+		}
+	}
+
+	return hugepages, nil
+}
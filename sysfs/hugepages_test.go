@@ -0,0 +1,58 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHugepages(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.Hugepages()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Hugepages{
+		2097152: {
+			Total:    1024,
+			Free:     1000,
+			Reserved: 12,
+			Nodes: map[int]NumaNodeHugepages{
+				1: {Total: 512, Free: 500},
+				2: {Total: 512, Free: 500},
+			},
+		},
+		1073741824: {
+			Total: 4,
+			Free:  2,
+			Nodes: map[int]NumaNodeHugepages{
+				1: {Total: 2, Free: 1},
+				2: {Total: 2, Free: 1},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected Hugepages (-want +got):\n%s", diff)
+	}
+}
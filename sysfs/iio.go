@@ -0,0 +1,201 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const iioDevicesPath = "bus/iio/devices"
+
+// IIOChannel contains the values of a single channel of an IIO device. The
+// files of a channel are named <Name>_<attribute>, like in_accel_x_raw .
+// Scale and Offset fall back to the values shared by all channels of the
+// same type, like in_accel_scale .
+type IIOChannel struct {
+	Name   string
+	Raw    *int64   // /sys/bus/iio/devices/<Device>/<Name>_raw
+	Input  *float64 // /sys/bus/iio/devices/<Device>/<Name>_input, already processed
+	Scale  *float64 // /sys/bus/iio/devices/<Device>/<Name>_scale
+	Offset *float64 // /sys/bus/iio/devices/<Device>/<Name>_offset
+}
+
+// IIODevice contains info from files in /sys/bus/iio/devices/iio:device<N>
+// for a single IIO device.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-bus-iio
+type IIODevice struct {
+	Name       string
+	DeviceName *string // /sys/bus/iio/devices/<Name>/name, e.g. the sensor part number
+	// Channels holds the input channels of the device keyed by channel
+	// name, like "in_accel_x".
+	Channels map[string]IIOChannel
+}
+
+// IIODevices is a collection of every IIO device in /sys/bus/iio/devices .
+// Triggers are skipped.
+//
+// The map keys are the device names, like "iio:device0".
+type IIODevices map[string]IIODevice
+
+// IIODevices returns info for all IIO devices read from
+// /sys/bus/iio/devices .
+func (fs FS) IIODevices() (IIODevices, error) {
+	path := fs.sys.Path(iioDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(IIODevices, len(dirs))
+	for _, d := range dirs {
+		if !strings.HasPrefix(d.Name(), "iio:device") {
+			continue
+		}
+
+		device, err := fs.parseIIODevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseIIODevice(name string) (*IIODevice, error) {
+	path := fs.sys.Path(iioDevicesPath, name)
+	device := &IIODevice{Name: name, Channels: map[string]IIOChannel{}}
+
+	file := filepath.Join(path, "name")
+	value, err := util.SysReadFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+	} else {
+		device.DeviceName = &value
+	}
+
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IIO device files at %q: %w", path, err)
+	}
+	for _, f := range files {
+		if !strings.HasPrefix(f.Name(), "in_") {
+			continue
+		}
+		channel, ok := strings.CutSuffix(f.Name(), "_raw")
+		if !ok {
+			channel, ok = strings.CutSuffix(f.Name(), "_input")
+		}
+		if !ok {
+			continue
+		}
+		if _, ok := device.Channels[channel]; ok {
+			continue
+		}
+
+		c, err := parseIIOChannel(path, channel)
+		if err != nil {
+			return nil, err
+		}
+		device.Channels[channel] = *c
+	}
+
+	return device, nil
+}
+
+func parseIIOChannel(path, name string) (*IIOChannel, error) {
+	channel := &IIOChannel{Name: name}
+	shared := iioChannelType(name)
+
+	for _, attr := range [...]string{"raw", "input", "scale", "offset"} {
+		file := filepath.Join(path, name+"_"+attr)
+		value, err := util.SysReadFile(file)
+		if os.IsNotExist(err) && (attr == "scale" || attr == "offset") {
+			file = filepath.Join(path, shared+"_"+attr)
+			value, err = util.SysReadFile(file)
+		}
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		if attr == "raw" {
+			v, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+			}
+			channel.Raw = &v
+			continue
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+		switch attr {
+		case "input":
+			channel.Input = &v
+		case "scale":
+			channel.Scale = &v
+		case "offset":
+			channel.Offset = &v
+		}
+	}
+
+	return channel, nil
+}
+
+// iioChannelType returns the prefix of the files shared by all channels of
+// the same type as the named channel, e.g. "in_accel" for "in_accel_x" and
+// "in_voltage" for "in_voltage0" or "in_voltage0-voltage1".
+func iioChannelType(name string) string {
+	direction, rest, _ := strings.Cut(name, "_")
+	typ, _, _ := strings.Cut(rest, "_")
+	typ, _, _ = strings.Cut(typ, "-")
+	return direction + "_" + strings.TrimRight(typ, "0123456789")
+}
+
+// Value returns the calibrated reading of the channel in the units defined
+// by the IIO ABI, computed as (Raw + Offset) * Scale. Processed Input values
+// are returned as is. The boolean is false if the channel has no reading.
+func (c IIOChannel) Value() (float64, bool) {
+	if c.Input != nil {
+		return *c.Input, true
+	}
+	if c.Raw == nil {
+		return 0, false
+	}
+
+	v := float64(*c.Raw)
+	if c.Offset != nil {
+		v += *c.Offset
+	}
+	if c.Scale != nil {
+		v *= *c.Scale
+	}
+	return v, true
+}
@@ -0,0 +1,100 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIIODevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.IIODevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := IIODevices{
+		"iio:device0": {
+			Name:       "iio:device0",
+			DeviceName: makeString("lis3dh"),
+			Channels: map[string]IIOChannel{
+				"in_accel_x":  {Name: "in_accel_x", Raw: makeInt64(-16), Scale: makeFloat64(0.00059855)},
+				"in_accel_y":  {Name: "in_accel_y", Raw: makeInt64(32), Scale: makeFloat64(0.00059855)},
+				"in_accel_z":  {Name: "in_accel_z", Raw: makeInt64(16400), Scale: makeFloat64(0.000598)},
+				"in_voltage0": {Name: "in_voltage0", Raw: makeInt64(1023), Scale: makeFloat64(0.805664062)},
+			},
+		},
+		"iio:device1": {
+			Name:       "iio:device1",
+			DeviceName: makeString("bmp280"),
+			Channels: map[string]IIOChannel{
+				"in_pressure": {Name: "in_pressure", Input: makeFloat64(100.213)},
+				"in_temp":     {Name: "in_temp", Input: makeFloat64(23450)},
+			},
+		},
+		"iio:device2": {
+			Name:       "iio:device2",
+			DeviceName: makeString("tmp006"),
+			Channels: map[string]IIOChannel{
+				"in_temp_object": {Name: "in_temp_object", Raw: makeInt64(-2743), Scale: makeFloat64(31.25), Offset: makeFloat64(-5)},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected IIO devices (-want +got):\n%s", diff)
+	}
+
+	for _, tt := range []struct {
+		device, channel string
+		want            float64
+	}{
+		{"iio:device0", "in_accel_z", 9.8072},
+		{"iio:device0", "in_voltage0", 824.194335426},
+		{"iio:device1", "in_pressure", 100.213},
+		{"iio:device2", "in_temp_object", -85875},
+	} {
+		v, ok := got[tt.device].Channels[tt.channel].Value()
+		if !ok || math.Abs(v-tt.want) > 1e-6 {
+			t.Errorf("%s %s: want value %v, got %v (%t)", tt.device, tt.channel, tt.want, v, ok)
+		}
+	}
+
+	if _, ok := (IIOChannel{Name: "in_accel_x"}).Value(); ok {
+		t.Error("expected no value for a channel without readings")
+	}
+}
+
+func TestIIOChannelType(t *testing.T) {
+	for name, want := range map[string]string{
+		"in_accel_x":           "in_accel",
+		"in_voltage0":          "in_voltage",
+		"in_voltage0-voltage1": "in_voltage",
+		"in_temp":              "in_temp",
+		"in_humidityrelative":  "in_humidityrelative",
+	} {
+		if got := iioChannelType(name); got != want {
+			t.Errorf("%s: want %q, got %q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const iommuGroupsPath = "kernel/iommu_groups"
+
+// IommuGroup contains info from files in /sys/kernel/iommu_groups for a
+// single IOMMU group.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-kernel-iommu_groups
+type IommuGroup struct {
+	ID      int
+	Type    *string  // /sys/kernel/iommu_groups/<ID>/type
+	Devices []string // /sys/kernel/iommu_groups/<ID>/devices
+}
+
+// IommuGroups is a collection of every IOMMU group in
+// /sys/kernel/iommu_groups .
+//
+// The map keys are the IOMMU group numbers.
+type IommuGroups map[int]IommuGroup
+
+// IommuGroups returns info for all IOMMU groups read from
+// /sys/kernel/iommu_groups .
+func (fs FS) IommuGroups() (IommuGroups, error) {
+	path := fs.sys.Path(iommuGroupsPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(IommuGroups, len(dirs))
+	for _, d := range dirs {
+		id, err := strconv.Atoi(d.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse IOMMU group %q: %w", d.Name(), err)
+		}
+
+		group, err := fs.parseIommuGroup(id)
+		if err != nil {
+			return nil, err
+		}
+
+		groups[id] = *group
+	}
+
+	return groups, nil
+}
+
+func (fs FS) parseIommuGroup(id int) (*IommuGroup, error) {
+	group := &IommuGroup{ID: id}
+	name := strconv.Itoa(id)
+
+	path := fs.sys.Path(iommuGroupsPath, name, "type")
+	value, err := util.SysReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+		}
+	} else {
+		group.Type = &value
+	}
+
+	path = fs.sys.Path(iommuGroupsPath, name, "devices")
+	devices, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IOMMU group devices at %q: %w", path, err)
+	}
+	for _, d := range devices {
+		group.Devices = append(group.Devices, d.Name())
+	}
+
+	return group, nil
+}
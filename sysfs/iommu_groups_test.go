@@ -0,0 +1,66 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIommuGroups(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.IommuGroups()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		typeDMA   = "DMA"
+		typeDMAFQ = "DMA-FQ"
+	)
+
+	want := IommuGroups{
+		2: {
+			ID:      2,
+			Type:    &typeDMAFQ,
+			Devices: []string{"0000:00:02.1"},
+		},
+		11: {
+			ID:      11,
+			Type:    &typeDMA,
+			Devices: []string{"0000:01:00.0"},
+		},
+		16: {
+			ID:      16,
+			Type:    &typeDMA,
+			Devices: []string{"0000:00:06.0", "0000:00:06.1"},
+		},
+		17: {
+			ID:      17,
+			Type:    &typeDMA,
+			Devices: []string{"0000:00:07.0", "0000:00:07.1"},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected IOMMU groups (-want +got):\n%s", diff)
+	}
+}
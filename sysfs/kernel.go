@@ -0,0 +1,73 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// KernelInfo contains generic kernel attributes from files in /sys/kernel .
+// The taint mask is only exposed in /proc/sys/kernel/tainted, see
+// procfs.FS.KernelTaint.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-kernel
+type KernelInfo struct {
+	KexecLoaded      *bool   // /sys/kernel/kexec_loaded
+	KexecCrashLoaded *bool   // /sys/kernel/kexec_crash_loaded
+	KexecCrashSize   *uint64 // /sys/kernel/kexec_crash_size, in bytes
+	Profiling        *int64  // /sys/kernel/profiling, 0 if kernel profiling is disabled
+}
+
+// KernelInfo returns the generic kernel attributes read from /sys/kernel .
+func (fs FS) KernelInfo() (*KernelInfo, error) {
+	path := fs.sys.Path("kernel")
+	info := &KernelInfo{}
+
+	for _, f := range [...]string{"kexec_loaded", "kexec_crash_loaded", "kexec_crash_size", "profiling"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "kexec_loaded":
+			v := vp.Int() != 0
+			info.KexecLoaded = &v
+		case "kexec_crash_loaded":
+			v := vp.Int() != 0
+			info.KexecCrashLoaded = &v
+		case "kexec_crash_size":
+			info.KexecCrashSize = vp.PUInt64()
+		case "profiling":
+			info.Profiling = vp.PInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,218 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	transparentHugepagePath = "kernel/mm/transparent_hugepage"
+	ksmPath                 = "kernel/mm/ksm"
+)
+
+// TransparentHugepageInfo contains the transparent hugepage settings and
+// khugepaged statistics from files in /sys/kernel/mm/transparent_hugepage .
+// https://www.kernel.org/doc/Documentation/admin-guide/mm/transhuge.rst
+type TransparentHugepageInfo struct {
+	Enabled      *string // /sys/kernel/mm/transparent_hugepage/enabled, e.g. madvise
+	Defrag       *string // /sys/kernel/mm/transparent_hugepage/defrag
+	ShmemEnabled *string // /sys/kernel/mm/transparent_hugepage/shmem_enabled
+	UseZeroPage  *bool   // /sys/kernel/mm/transparent_hugepage/use_zero_page
+	HPagePMDSize *uint64 // /sys/kernel/mm/transparent_hugepage/hpage_pmd_size, in bytes
+
+	Khugepaged KhugepagedInfo
+}
+
+// KhugepagedInfo contains info from files in
+// /sys/kernel/mm/transparent_hugepage/khugepaged .
+type KhugepagedInfo struct {
+	Defrag              *bool   // khugepaged/defrag
+	PagesToScan         *uint64 // khugepaged/pages_to_scan
+	ScanSleepMillisecs  *uint64 // khugepaged/scan_sleep_millisecs
+	AllocSleepMillisecs *uint64 // khugepaged/alloc_sleep_millisecs
+	MaxPtesNone         *uint64 // khugepaged/max_ptes_none
+	MaxPtesSwap         *uint64 // khugepaged/max_ptes_swap
+	MaxPtesShared       *uint64 // khugepaged/max_ptes_shared
+	PagesCollapsed      *uint64 // khugepaged/pages_collapsed
+	FullScans           *uint64 // khugepaged/full_scans
+}
+
+// KsmInfo contains the kernel samepage merging settings and statistics
+// from files in /sys/kernel/mm/ksm .
+// https://www.kernel.org/doc/Documentation/admin-guide/mm/ksm.rst
+type KsmInfo struct {
+	Run              *uint64 // /sys/kernel/mm/ksm/run, 0 stopped, 1 running, 2 unmerge
+	PagesToScan      *uint64 // /sys/kernel/mm/ksm/pages_to_scan
+	SleepMillisecs   *uint64 // /sys/kernel/mm/ksm/sleep_millisecs
+	MergeAcrossNodes *bool   // /sys/kernel/mm/ksm/merge_across_nodes
+	PagesShared      *uint64 // /sys/kernel/mm/ksm/pages_shared
+	PagesSharing     *uint64 // /sys/kernel/mm/ksm/pages_sharing
+	PagesUnshared    *uint64 // /sys/kernel/mm/ksm/pages_unshared
+	PagesVolatile    *uint64 // /sys/kernel/mm/ksm/pages_volatile
+	FullScans        *uint64 // /sys/kernel/mm/ksm/full_scans
+	ZeroPages        *uint64 // /sys/kernel/mm/ksm/ksm_zero_pages
+	GeneralProfit    *int64  // /sys/kernel/mm/ksm/general_profit, in bytes
+}
+
+// TransparentHugepageInfo returns the transparent hugepage settings read
+// from /sys/kernel/mm/transparent_hugepage .
+func (fs FS) TransparentHugepageInfo() (*TransparentHugepageInfo, error) {
+	path := fs.sys.Path(transparentHugepagePath)
+	info := &TransparentHugepageInfo{}
+
+	for _, f := range [...]string{"enabled", "defrag", "shmem_enabled", "use_zero_page", "hpage_pmd_size"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "enabled":
+			info.Enabled = selectedMode(value)
+		case "defrag":
+			info.Defrag = selectedMode(value)
+		case "shmem_enabled":
+			info.ShmemEnabled = selectedMode(value)
+		case "use_zero_page":
+			v := vp.Int() != 0
+			info.UseZeroPage = &v
+		case "hpage_pmd_size":
+			info.HPagePMDSize = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	k := &info.Khugepaged
+	for _, f := range [...]string{"defrag", "pages_to_scan", "scan_sleep_millisecs", "alloc_sleep_millisecs", "max_ptes_none", "max_ptes_swap", "max_ptes_shared", "pages_collapsed", "full_scans"} {
+		file := filepath.Join(path, "khugepaged", f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "defrag":
+			v := vp.Int() != 0
+			k.Defrag = &v
+		case "pages_to_scan":
+			k.PagesToScan = vp.PUInt64()
+		case "scan_sleep_millisecs":
+			k.ScanSleepMillisecs = vp.PUInt64()
+		case "alloc_sleep_millisecs":
+			k.AllocSleepMillisecs = vp.PUInt64()
+		case "max_ptes_none":
+			k.MaxPtesNone = vp.PUInt64()
+		case "max_ptes_swap":
+			k.MaxPtesSwap = vp.PUInt64()
+		case "max_ptes_shared":
+			k.MaxPtesShared = vp.PUInt64()
+		case "pages_collapsed":
+			k.PagesCollapsed = vp.PUInt64()
+		case "full_scans":
+			k.FullScans = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return info, nil
+}
+
+// KsmInfo returns the kernel samepage merging state read from
+// /sys/kernel/mm/ksm .
+func (fs FS) KsmInfo() (*KsmInfo, error) {
+	path := fs.sys.Path(ksmPath)
+	info := &KsmInfo{}
+
+	for _, f := range [...]string{"run", "pages_to_scan", "sleep_millisecs", "merge_across_nodes", "pages_shared", "pages_sharing", "pages_unshared", "pages_volatile", "full_scans", "ksm_zero_pages", "general_profit"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "run":
+			info.Run = vp.PUInt64()
+		case "pages_to_scan":
+			info.PagesToScan = vp.PUInt64()
+		case "sleep_millisecs":
+			info.SleepMillisecs = vp.PUInt64()
+		case "merge_across_nodes":
+			v := vp.Int() != 0
+			info.MergeAcrossNodes = &v
+		case "pages_shared":
+			info.PagesShared = vp.PUInt64()
+		case "pages_sharing":
+			info.PagesSharing = vp.PUInt64()
+		case "pages_unshared":
+			info.PagesUnshared = vp.PUInt64()
+		case "pages_volatile":
+			info.PagesVolatile = vp.PUInt64()
+		case "full_scans":
+			info.FullScans = vp.PUInt64()
+		case "ksm_zero_pages":
+			info.ZeroPages = vp.PUInt64()
+		case "general_profit":
+			info.GeneralProfit = vp.PInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return info, nil
+}
+
+// selectedMode returns the option enclosed in brackets in a list like
+// "always [madvise] never", or nil if none is selected.
+func selectedMode(value string) *string {
+	for option := range strings.FieldsSeq(value) {
+		if mode, ok := strings.CutPrefix(option, "["); ok {
+			mode = strings.TrimSuffix(mode, "]")
+			return &mode
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransparentHugepageInfo(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.TransparentHugepageInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &TransparentHugepageInfo{
+		Enabled:      makeString("madvise"),
+		Defrag:       makeString("madvise"),
+		ShmemEnabled: makeString("never"),
+		UseZeroPage:  makeBool(true),
+		HPagePMDSize: makeUint64(2097152),
+		Khugepaged: KhugepagedInfo{
+			Defrag:              makeBool(true),
+			PagesToScan:         makeUint64(4096),
+			ScanSleepMillisecs:  makeUint64(10000),
+			AllocSleepMillisecs: makeUint64(60000),
+			MaxPtesNone:         makeUint64(511),
+			MaxPtesSwap:         makeUint64(64),
+			MaxPtesShared:       makeUint64(256),
+			PagesCollapsed:      makeUint64(1523),
+			FullScans:           makeUint64(87),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected TransparentHugepageInfo (-want +got):\n%s", diff)
+	}
+}
+
+func TestKsmInfo(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.KsmInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profit := int64(139108352)
+	mergeAcrossNodes := true
+
+	want := &KsmInfo{
+		Run:              makeUint64(1),
+		PagesToScan:      makeUint64(100),
+		SleepMillisecs:   makeUint64(20),
+		MergeAcrossNodes: &mergeAcrossNodes,
+		PagesShared:      makeUint64(4127),
+		PagesSharing:     makeUint64(38211),
+		PagesUnshared:    makeUint64(91034),
+		PagesVolatile:    makeUint64(2210),
+		FullScans:        makeUint64(312),
+		ZeroPages:        makeUint64(0),
+		GeneralProfit:    &profit,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected KsmInfo (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKernelInfo(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.KernelInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		kexecLoaded      = false
+		kexecCrashLoaded = true
+		kexecCrashSize   = uint64(268435456)
+		profiling        = int64(0)
+	)
+
+	want := &KernelInfo{
+		KexecLoaded:      &kexecLoaded,
+		KexecCrashLoaded: &kexecCrashLoaded,
+		KexecCrashSize:   &kexecCrashSize,
+		Profiling:        &profiling,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected KernelInfo (-want +got):\n%s", diff)
+	}
+}
@@ -17,7 +17,9 @@ package sysfs
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/procfs/internal/util"
@@ -40,11 +42,13 @@ type Mdraid struct {
 	DegradedDisks uint64  // Number of degraded disks in the array.
 	SyncAction    string  // Current sync action.
 	SyncCompleted float64 // Fraction (0-1) representing the completion status of current sync operation.
+	MismatchCount uint64  // Number of sectors found inconsistent by the last check or repair.
 }
 
 type MdraidComponent struct {
-	Device string // Kernel device name.
-	State  string // Current state of device.
+	Device string  // Kernel device name.
+	State  string  // Current state of device.
+	Slot   *uint64 // Role of the device in the array from the rd<N> links, nil if it has none.
 }
 
 // Mdraids gathers information and statistics about mdraid devices present. Based on upstream
@@ -93,9 +97,30 @@ func (fs FS) Mdraids() ([]Mdraid, error) {
 			return mdraids, err
 		}
 
+		// Each active slot N has a rd<N> link to the component filling it.
+		slots := make(map[string]uint64)
+		if rds, err := filepath.Glob(filepath.Join(path, "rd[0-9]*")); err == nil {
+			for _, rd := range rds {
+				n, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(rd), "rd"), 10, 64)
+				if err != nil {
+					continue
+				}
+				target, err := os.Readlink(rd)
+				if err != nil {
+					return mdraids, err
+				}
+				slots[filepath.Base(target)] = n
+			}
+		} else {
+			return mdraids, err
+		}
+
 		if devs, err := filepath.Glob(filepath.Join(path, "dev-*")); err == nil {
 			for _, dev := range devs {
 				comp := MdraidComponent{Device: strings.TrimPrefix(filepath.Base(dev), "dev-")}
+				if n, ok := slots[filepath.Base(dev)]; ok {
+					comp.Slot = &n
+				}
 
 				// Component state can be a comma-separated list of: faulty, in_sync, writemostly,
 				// blocked, spare, write_error, want_replacement, replacement.
@@ -152,6 +177,14 @@ func (fs FS) Mdraids() ([]Mdraid, error) {
 			}
 		}
 
+		// raid0 and linear arrays have no redundancy to check and thus no
+		// mismatch_cnt.
+		if val, err := util.ReadUintFromFile(filepath.Join(path, "mismatch_cnt")); err == nil {
+			md.MismatchCount = val
+		} else if !os.IsNotExist(err) {
+			return mdraids, err
+		}
+
 		mdraids = append(mdraids, md)
 	}
 
@@ -40,8 +40,8 @@ func TestMdraidStats(t *testing.T) {
 			MetadataVersion: "1.2",
 			Disks:           2,
 			Components: []MdraidComponent{
-				{Device: "sdg", State: "in_sync"},
-				{Device: "sdh", State: "in_sync"},
+				{Device: "sdg", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdh", State: "in_sync", Slot: makeUint64(1)},
 			},
 			UUID:      "155f29ff-1716-4107-b362-52307ef86cac",
 			ChunkSize: 524288,
@@ -53,11 +53,12 @@ func TestMdraidStats(t *testing.T) {
 			MetadataVersion: "1.2",
 			Disks:           2,
 			Components: []MdraidComponent{
-				{Device: "sdi", State: "in_sync"},
-				{Device: "sdj", State: "in_sync"},
+				{Device: "sdi", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdj", State: "in_sync", Slot: makeUint64(1)},
 			},
-			UUID:       "0fbf5f2c-add2-43c2-bd78-a4be3ab709ef",
-			SyncAction: "idle",
+			UUID:          "0fbf5f2c-add2-43c2-bd78-a4be3ab709ef",
+			SyncAction:    "idle",
+			MismatchCount: 256,
 		},
 		{
 			Device:          "md10",
@@ -66,10 +67,10 @@ func TestMdraidStats(t *testing.T) {
 			MetadataVersion: "1.2",
 			Disks:           4,
 			Components: []MdraidComponent{
-				{Device: "sdu", State: "in_sync"},
-				{Device: "sdv", State: "in_sync"},
-				{Device: "sdw", State: "in_sync"},
-				{Device: "sdx", State: "in_sync"},
+				{Device: "sdu", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdv", State: "in_sync", Slot: makeUint64(1)},
+				{Device: "sdw", State: "in_sync", Slot: makeUint64(2)},
+				{Device: "sdx", State: "in_sync", Slot: makeUint64(3)},
 			},
 			UUID:       "0c15f7e7-b159-4b1f-a5cd-a79b5c04b6f5",
 			ChunkSize:  524288,
@@ -82,9 +83,9 @@ func TestMdraidStats(t *testing.T) {
 			MetadataVersion: "1.2",
 			Disks:           3,
 			Components: []MdraidComponent{
-				{Device: "sdk", State: "in_sync"},
-				{Device: "sdl", State: "in_sync"},
-				{Device: "sdm", State: "in_sync"},
+				{Device: "sdk", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdl", State: "in_sync", Slot: makeUint64(1)},
+				{Device: "sdm", State: "in_sync", Slot: makeUint64(2)},
 			},
 			UUID:       "67f415d5-2c0c-4b69-8e0d-7e20ef553457",
 			ChunkSize:  524288,
@@ -98,9 +99,9 @@ func TestMdraidStats(t *testing.T) {
 			Disks:           3,
 			Components: []MdraidComponent{
 				{Device: "sdaa", State: "spare"},
-				{Device: "sdn", State: "in_sync"},
-				{Device: "sdo", State: "in_sync"},
-				{Device: "sdp", State: "faulty"},
+				{Device: "sdn", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdo", State: "in_sync", Slot: makeUint64(1)},
+				{Device: "sdp", State: "faulty", Slot: makeUint64(2)},
 			},
 			UUID:          "7615b98d-f2ba-4d99-bee8-6202d8e130b9",
 			ChunkSize:     524288,
@@ -114,10 +115,10 @@ func TestMdraidStats(t *testing.T) {
 			MetadataVersion: "1.2",
 			Disks:           4,
 			Components: []MdraidComponent{
-				{Device: "sdq", State: "in_sync"},
-				{Device: "sdr", State: "in_sync"},
-				{Device: "sds", State: "in_sync"},
-				{Device: "sdt", State: "spare"},
+				{Device: "sdq", State: "in_sync", Slot: makeUint64(0)},
+				{Device: "sdr", State: "in_sync", Slot: makeUint64(1)},
+				{Device: "sds", State: "in_sync", Slot: makeUint64(2)},
+				{Device: "sdt", State: "spare", Slot: makeUint64(3)},
 			},
 			UUID:          "5f529b25-6efd-46e4-99a2-31f6f597be6b",
 			ChunkSize:     524288,
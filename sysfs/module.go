@@ -0,0 +1,137 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const modulePath = "module"
+
+// Module contains info from files in /sys/module/<Name> for a single kernel
+// module. Modules built into the kernel only expose their parameters.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-module
+type Module struct {
+	Name       string
+	RefCnt     *int64  // /sys/module/<Name>/refcnt
+	CoreSize   *uint64 // /sys/module/<Name>/coresize
+	InitSize   *uint64 // /sys/module/<Name>/initsize
+	Taint      *string // /sys/module/<Name>/taint, e.g. "OE"
+	InitState  *string // /sys/module/<Name>/initstate, e.g. live, coming or going
+	Version    *string // /sys/module/<Name>/version
+	SrcVersion *string // /sys/module/<Name>/srcversion
+	// Parameters holds the current values of the module parameters keyed by
+	// parameter name. Write-only parameters are skipped.
+	// /sys/module/<Name>/parameters/<Parameter>
+	Parameters map[string]string
+}
+
+// Modules is a collection of every kernel module in /sys/module .
+//
+// The map keys are the module names.
+type Modules map[string]Module
+
+// Modules returns info for all kernel modules read from /sys/module .
+func (fs FS) Modules() (Modules, error) {
+	path := fs.sys.Path(modulePath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list modules at %q: %w", path, err)
+	}
+
+	modules := make(Modules, len(dirs))
+	for _, d := range dirs {
+		module, err := fs.parseModule(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		modules[module.Name] = *module
+	}
+
+	return modules, nil
+}
+
+func (fs FS) parseModule(name string) (*Module, error) {
+	path := fs.sys.Path(modulePath, name)
+	module := &Module{Name: name}
+
+	for _, f := range [...]string{"refcnt", "coresize", "initsize", "taint", "initstate", "version", "srcversion"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "refcnt":
+			module.RefCnt = vp.PInt64()
+		case "coresize":
+			module.CoreSize = vp.PUInt64()
+		case "initsize":
+			module.InitSize = vp.PUInt64()
+		case "taint":
+			module.Taint = &value
+		case "initstate":
+			module.InitState = &value
+		case "version":
+			module.Version = &value
+		case "srcversion":
+			module.SrcVersion = &value
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	paramsPath := filepath.Join(path, "parameters")
+	params, err := os.ReadDir(paramsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return module, nil
+		}
+		return nil, fmt.Errorf("failed to list module parameters at %q: %w", paramsPath, err)
+	}
+
+	module.Parameters = make(map[string]string, len(params))
+	for _, p := range params {
+		// Parameter values like arrays can exceed the size read by
+		// SysReadFile.
+		file := filepath.Join(paramsPath, p.Name())
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if os.IsPermission(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		module.Parameters[p.Name()] = strings.TrimSpace(string(data))
+	}
+
+	return module, nil
+}
@@ -0,0 +1,83 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestModules(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.Modules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Modules{
+		"crc32c_generic": {
+			Name:      "crc32c_generic",
+			RefCnt:    makeInt64(0),
+			CoreSize:  makeUint64(16384),
+			InitSize:  makeUint64(0),
+			Taint:     makeString(""),
+			InitState: makeString("live"),
+		},
+		"kvm": {
+			Name:       "kvm",
+			RefCnt:     makeInt64(1),
+			CoreSize:   makeUint64(1368064),
+			InitSize:   makeUint64(0),
+			Taint:      makeString(""),
+			InitState:  makeString("live"),
+			SrcVersion: makeString("4F8D2E6C2DE5B3F1A4E9C77"),
+			Parameters: map[string]string{
+				"halt_poll_ns":  "200000",
+				"nx_huge_pages": "N",
+			},
+		},
+		"nvidia": {
+			Name:       "nvidia",
+			RefCnt:     makeInt64(120),
+			CoreSize:   makeUint64(62427136),
+			InitSize:   makeUint64(0),
+			Taint:      makeString("POE"),
+			InitState:  makeString("live"),
+			Version:    makeString("550.54.14"),
+			SrcVersion: makeString("1D23E5ED7C6F6E4D5C0F5A1"),
+			Parameters: map[string]string{
+				"NVreg_EnableMSI":      "1",
+				"NVreg_RegistryDwords": "",
+			},
+		},
+		"printk": {
+			Name: "printk",
+			Parameters: map[string]string{
+				"console_suspend": "Y",
+				"time":            "Y",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected modules (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	ndDevicesPath  = "bus/nd/devices"
+	daxDevicesPath = "bus/dax/devices"
+)
+
+// NdMapping describes the part of a DIMM backing a region, as listed in
+// /sys/bus/nd/devices/region<N>/mapping<M> .
+type NdMapping struct {
+	Dimm     string // nmem device name
+	Offset   uint64 // offset into the DIMM in bytes
+	Length   uint64 // length in bytes
+	Position int64  // position in the interleave set, -1 if unknown
+}
+
+// NdBadblock is a range of bad sectors listed in
+// /sys/bus/nd/devices/region<N>/badblocks .
+type NdBadblock struct {
+	Sector uint64 // first bad 512-byte sector
+	Count  uint64 // number of bad sectors
+}
+
+// NdNamespace contains info from files in
+// /sys/bus/nd/devices/namespace<N>.<M> for a single NVDIMM namespace.
+type NdNamespace struct {
+	Name    string
+	Mode    *string // /sys/bus/nd/devices/<Name>/mode, e.g. fsdax or devdax
+	Size    *uint64 // /sys/bus/nd/devices/<Name>/size
+	UUID    *string // /sys/bus/nd/devices/<Name>/uuid
+	DevType *string // /sys/bus/nd/devices/<Name>/devtype
+}
+
+// NdRegion contains info from files in /sys/bus/nd/devices/region<N> for a
+// single NVDIMM region.
+// https://www.kernel.org/doc/Documentation/driver-api/nvdimm/nvdimm.rst
+type NdRegion struct {
+	Name          string
+	Size          *uint64       // /sys/bus/nd/devices/<Name>/size
+	AvailableSize *uint64       // /sys/bus/nd/devices/<Name>/available_size
+	NumaNode      *int64        // /sys/bus/nd/devices/<Name>/numa_node
+	Mappings      []NdMapping   // /sys/bus/nd/devices/<Name>/mapping<M>
+	Badblocks     []NdBadblock  // /sys/bus/nd/devices/<Name>/badblocks
+	Namespaces    []NdNamespace // namespaces carved out of the region
+}
+
+// NdRegions is a collection of every NVDIMM region in /sys/bus/nd/devices .
+//
+// The map keys are the region names.
+type NdRegions map[string]NdRegion
+
+// DaxDevice contains info from files in /sys/bus/dax/devices for a single
+// device-dax instance.
+type DaxDevice struct {
+	Name       string
+	Size       *uint64 // /sys/bus/dax/devices/<Name>/size
+	Align      *uint64 // /sys/bus/dax/devices/<Name>/align
+	TargetNode *int64  // /sys/bus/dax/devices/<Name>/target_node
+	NumaNode   *int64  // /sys/bus/dax/devices/<Name>/numa_node
+}
+
+// DaxDevices is a collection of every device-dax instance in
+// /sys/bus/dax/devices .
+//
+// The map keys are the device names.
+type DaxDevices map[string]DaxDevice
+
+// NdctlRegions returns info for all NVDIMM regions and their namespaces read
+// from /sys/bus/nd/devices .
+func (fs FS) NdctlRegions() (NdRegions, error) {
+	path := fs.sys.Path(ndDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := NdRegions{}
+	namespaces := map[string][]NdNamespace{}
+	for _, d := range dirs {
+		name := d.Name()
+		switch {
+		case strings.HasPrefix(name, "region"):
+			region, err := fs.parseNdRegion(name)
+			if err != nil {
+				return nil, err
+			}
+			regions[name] = *region
+		case strings.HasPrefix(name, "namespace"):
+			namespace, err := fs.parseNdNamespace(name)
+			if err != nil {
+				return nil, err
+			}
+			// Namespaces are named namespace<region ID>.<N>.
+			id, _, _ := strings.Cut(strings.TrimPrefix(name, "namespace"), ".")
+			namespaces["region"+id] = append(namespaces["region"+id], *namespace)
+		}
+	}
+
+	for name, region := range regions {
+		region.Namespaces = namespaces[name]
+		regions[name] = region
+	}
+
+	return regions, nil
+}
+
+func (fs FS) parseNdRegion(name string) (*NdRegion, error) {
+	path := fs.sys.Path(ndDevicesPath, name)
+	region := &NdRegion{Name: name}
+
+	for _, f := range [...]string{"size", "available_size", "numa_node"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "size":
+			region.Size = vp.PUInt64()
+		case "available_size":
+			region.AvailableSize = vp.PUInt64()
+		case "numa_node":
+			region.NumaNode = vp.PInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	mappings, err := util.ReadUintFromFile(filepath.Join(path, "mappings"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read file %q: %w", filepath.Join(path, "mappings"), err)
+	}
+	for i := range mappings {
+		file := filepath.Join(path, fmt.Sprintf("mapping%d", i))
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		mapping, err := parseNdMapping(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+		region.Mappings = append(region.Mappings, *mapping)
+	}
+
+	file := filepath.Join(path, "badblocks")
+	data, err := util.ReadFileNoStat(file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed badblocks line in %q: %q", file, line)
+		}
+		values, err := util.ParseUint64s(fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse badblocks line in %q: %w", file, err)
+		}
+		region.Badblocks = append(region.Badblocks, NdBadblock{Sector: values[0], Count: values[1]})
+	}
+
+	return region, nil
+}
+
+// parseNdMapping parses a "<dimm>,<offset>,<length>[,<position>]" mapping.
+// The position was added in kernel v4.10.
+func parseNdMapping(value string) (*NdMapping, error) {
+	fields := strings.Split(value, ",")
+	if len(fields) != 3 && len(fields) != 4 {
+		return nil, fmt.Errorf("unexpected number of fields: %q", value)
+	}
+
+	values, err := util.ParseUint64s(fields[1:3])
+	if err != nil {
+		return nil, err
+	}
+	mapping := &NdMapping{
+		Dimm:     fields[0],
+		Offset:   values[0],
+		Length:   values[1],
+		Position: -1,
+	}
+
+	if len(fields) == 4 {
+		mapping.Position, err = strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mapping, nil
+}
+
+func (fs FS) parseNdNamespace(name string) (*NdNamespace, error) {
+	path := fs.sys.Path(ndDevicesPath, name)
+	namespace := &NdNamespace{Name: name}
+
+	for _, f := range [...]string{"mode", "size", "uuid", "devtype"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		// Unconfigured namespaces report empty values.
+		if value == "" {
+			continue
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "mode":
+			namespace.Mode = &value
+		case "size":
+			namespace.Size = vp.PUInt64()
+		case "uuid":
+			namespace.UUID = &value
+		case "devtype":
+			namespace.DevType = &value
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return namespace, nil
+}
+
+// DaxDevices returns info for all device-dax instances read from
+// /sys/bus/dax/devices .
+func (fs FS) DaxDevices() (DaxDevices, error) {
+	path := fs.sys.Path(daxDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(DaxDevices, len(dirs))
+	for _, d := range dirs {
+		device := DaxDevice{Name: d.Name()}
+
+		for _, f := range [...]string{"size", "align", "target_node", "numa_node"} {
+			file := filepath.Join(path, d.Name(), f)
+			value, err := util.SysReadFile(file)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+
+			vp := util.NewValueParser(value)
+
+			switch f {
+			case "size":
+				device.Size = vp.PUInt64()
+			case "align":
+				device.Align = vp.PUInt64()
+			case "target_node":
+				device.TargetNode = vp.PInt64()
+			case "numa_node":
+				device.NumaNode = vp.PInt64()
+			}
+
+			if err := vp.Err(); err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+			}
+		}
+
+		devices[device.Name] = device
+	}
+
+	return devices, nil
+}
+
+// BadblocksBytes returns the total size of all bad blocks in the region in
+// bytes.
+func (r NdRegion) BadblocksBytes() uint64 {
+	var sectors uint64
+	for _, b := range r.Badblocks {
+		sectors += b.Count
+	}
+	return sectors * 512
+}
@@ -0,0 +1,133 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNdctlRegions(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NdctlRegions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		size          uint64 = 68719476736
+		availableSize uint64
+		numaNode      int64
+		seedSize      uint64
+		devdax        = "devdax"
+		raw           = "raw"
+		uuid          = "0b76a0e5-3fe6-4ac6-b1ad-4fd3bc6b5a4b"
+		devType       = "nd_namespace_pmem"
+	)
+
+	want := NdRegions{
+		"region0": {
+			Name:          "region0",
+			Size:          &size,
+			AvailableSize: &availableSize,
+			NumaNode:      &numaNode,
+			Mappings: []NdMapping{
+				{Dimm: "nmem0", Offset: 0, Length: 34359738368, Position: 0},
+				{Dimm: "nmem1", Offset: 0, Length: 34359738368, Position: 1},
+			},
+			Badblocks: []NdBadblock{
+				{Sector: 2048, Count: 8},
+				{Sector: 65536, Count: 1},
+			},
+			Namespaces: []NdNamespace{
+				{
+					Name:    "namespace0.0",
+					Mode:    &devdax,
+					Size:    &size,
+					UUID:    &uuid,
+					DevType: &devType,
+				},
+				{
+					Name:    "namespace0.1",
+					Mode:    &raw,
+					Size:    &seedSize,
+					DevType: &devType,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected NdctlRegions (-want +got):\n%s", diff)
+	}
+
+	if want, got := uint64(4608), got["region0"].BadblocksBytes(); want != got {
+		t.Errorf("unexpected BadblocksBytes: want %d, got %d", want, got)
+	}
+}
+
+func TestParseNdMapping(t *testing.T) {
+	got, err := parseNdMapping("nmem2,4096,1073741824")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &NdMapping{Dimm: "nmem2", Offset: 4096, Length: 1073741824, Position: -1}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected mapping (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseNdMapping("nmem2,4096"); err == nil {
+		t.Error("expected error for truncated mapping")
+	}
+}
+
+func TestDaxDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.DaxDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		size       uint64 = 67645734912
+		align      uint64 = 2097152
+		targetNode int64  = 2
+		numaNode   int64
+	)
+
+	want := DaxDevices{
+		"dax0.0": {
+			Name:       "dax0.0",
+			Size:       &size,
+			Align:      &align,
+			TargetNode: &targetNode,
+			NumaNode:   &numaNode,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected DaxDevices (-want +got):\n%s", diff)
+	}
+}
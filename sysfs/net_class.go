@@ -40,6 +40,7 @@ type NetClassIface struct {
 	CarrierUpCount   *int64 // /sys/class/net/<iface>/carrier_up_count
 	CarrierDownCount *int64 // /sys/class/net/<iface>/carrier_down_count
 	DevID            *int64 // /sys/class/net/<iface>/dev_id
+	DevPort          *int64 // /sys/class/net/<iface>/dev_port
 	Dormant          *int64 // /sys/class/net/<iface>/dormant
 	Duplex           string // /sys/class/net/<iface>/duplex
 	Flags            *int64 // /sys/class/net/<iface>/flags
@@ -173,6 +174,8 @@ func ParseNetClassAttribute(devicePath, attrName string, interfaceClass *NetClas
 		interfaceClass.CarrierDownCount = vp.PInt64()
 	case "dev_id":
 		interfaceClass.DevID = vp.PInt64()
+	case "dev_port":
+		interfaceClass.DevPort = vp.PInt64()
 	case "dormant":
 		interfaceClass.Dormant = vp.PInt64()
 	case "duplex":
@@ -0,0 +1,233 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// Bond contains info from files in /sys/class/net/<bond>/bonding for a
+// single bonding interface.
+// https://www.kernel.org/doc/Documentation/networking/bonding.rst
+type Bond struct {
+	Name           string   // Interface name
+	Mode           *string  // /sys/class/net/<bond>/bonding/mode, like "802.3ad"
+	ActiveSlave    *string  // /sys/class/net/<bond>/bonding/active_slave
+	Slaves         []string // /sys/class/net/<bond>/bonding/slaves
+	MIIStatus      *string  // /sys/class/net/<bond>/bonding/mii_status
+	MIIMon         *uint64  // /sys/class/net/<bond>/bonding/miimon, in milliseconds
+	LACPRate       *string  // /sys/class/net/<bond>/bonding/lacp_rate, like "slow"
+	XmitHashPolicy *string  // /sys/class/net/<bond>/bonding/xmit_hash_policy, like "layer3+4"
+	ADActorKey     *uint64  // /sys/class/net/<bond>/bonding/ad_actor_key
+	ADPartnerKey   *uint64  // /sys/class/net/<bond>/bonding/ad_partner_key
+	ADActorSystem  *string  // /sys/class/net/<bond>/bonding/ad_actor_system
+	ADPartnerMAC   *string  // /sys/class/net/<bond>/bonding/ad_partner_mac
+	ADAggregator   *uint64  // /sys/class/net/<bond>/bonding/ad_aggregator
+	ADNumPorts     *uint64  // /sys/class/net/<bond>/bonding/ad_num_ports
+
+	// SlaveInfo holds the state of each slave. The map keys are the slave
+	// interface names.
+	SlaveInfo map[string]BondSlave
+}
+
+// BondSlave contains info from files in /sys/class/net/<slave>/bonding_slave
+// for a single interface enslaved to a bond.
+type BondSlave struct {
+	Name                   string  // Interface name
+	State                  *string // /sys/class/net/<slave>/bonding_slave/state, "active" or "backup"
+	MIIStatus              *string // /sys/class/net/<slave>/bonding_slave/mii_status
+	LinkFailureCount       *uint64 // /sys/class/net/<slave>/bonding_slave/link_failure_count
+	PermHWAddr             *string // /sys/class/net/<slave>/bonding_slave/perm_hwaddr
+	QueueID                *uint64 // /sys/class/net/<slave>/bonding_slave/queue_id
+	ADAggregatorID         *uint64 // /sys/class/net/<slave>/bonding_slave/ad_aggregator_id
+	ADActorOperPortState   *uint64 // /sys/class/net/<slave>/bonding_slave/ad_actor_oper_port_state
+	ADPartnerOperPortState *uint64 // /sys/class/net/<slave>/bonding_slave/ad_partner_oper_port_state
+}
+
+// Bonds is collection of info for every bonding interface in /sys/class/net.
+// The map keys are interface (bond) names.
+type Bonds map[string]Bond
+
+// BondInterfaces returns info for all bonding interfaces read from
+// /sys/class/net/<bond>/bonding and the bonding_slave directories of their
+// slaves.
+func (fs FS) BondInterfaces() (Bonds, error) {
+	devices, err := fs.NetClassDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	bonds := Bonds{}
+	for _, devicePath := range devices {
+		bondingPath := fs.sys.Path(netclassPath, devicePath, "bonding")
+		if _, err := os.Stat(bondingPath); os.IsNotExist(err) {
+			continue
+		}
+
+		bond, err := parseBond(bondingPath)
+		if err != nil {
+			return nil, err
+		}
+		bond.Name = devicePath
+
+		for _, slave := range bond.Slaves {
+			slaveInfo, err := parseBondSlave(fs.sys.Path(netclassPath, slave, "bonding_slave"))
+			if err != nil {
+				return nil, err
+			}
+			slaveInfo.Name = slave
+			bond.SlaveInfo[slave] = *slaveInfo
+		}
+
+		bonds[devicePath] = *bond
+	}
+
+	return bonds, nil
+}
+
+// readBondFile reads a bonding attribute. The slaves list of a bond with many
+// slaves is longer than the 128 bytes util.SysReadFile reads, so it is read
+// in full.
+func readBondFile(name string) (string, error) {
+	if filepath.Base(name) != "slaves" {
+		return util.SysReadFile(name)
+	}
+	data, err := util.ReadFileNoStat(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func parseBond(path string) (*Bond, error) {
+	bond := Bond{SlaveInfo: map[string]BondSlave{}}
+
+	for _, f := range [...]string{
+		"mode", "active_slave", "slaves", "mii_status", "miimon", "lacp_rate", "xmit_hash_policy",
+		"ad_actor_key", "ad_partner_key", "ad_actor_system", "ad_partner_mac", "ad_aggregator", "ad_num_ports",
+	} {
+		name := filepath.Join(path, f)
+		value, err := readBondFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		// The 802.3ad attributes are empty in other modes or without
+		// CAP_NET_ADMIN, and active_slave is empty if there is none.
+		if value == "" {
+			continue
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "mode":
+			bond.Mode = bondOptionName(value)
+		case "active_slave":
+			bond.ActiveSlave = &value
+		case "slaves":
+			bond.Slaves = strings.Fields(value)
+		case "mii_status":
+			bond.MIIStatus = &value
+		case "miimon":
+			bond.MIIMon = vp.PUInt64()
+		case "lacp_rate":
+			bond.LACPRate = bondOptionName(value)
+		case "xmit_hash_policy":
+			bond.XmitHashPolicy = bondOptionName(value)
+		case "ad_actor_key":
+			bond.ADActorKey = vp.PUInt64()
+		case "ad_partner_key":
+			bond.ADPartnerKey = vp.PUInt64()
+		case "ad_actor_system":
+			bond.ADActorSystem = &value
+		case "ad_partner_mac":
+			bond.ADPartnerMAC = &value
+		case "ad_aggregator":
+			bond.ADAggregator = vp.PUInt64()
+		case "ad_num_ports":
+			bond.ADNumPorts = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	return &bond, nil
+}
+
+func parseBondSlave(path string) (*BondSlave, error) {
+	slave := BondSlave{}
+
+	for _, f := range [...]string{
+		"state", "mii_status", "link_failure_count", "perm_hwaddr", "queue_id",
+		"ad_aggregator_id", "ad_actor_oper_port_state", "ad_partner_oper_port_state",
+	} {
+		name := filepath.Join(path, f)
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		if value == "" {
+			continue
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "state":
+			slave.State = &value
+		case "mii_status":
+			slave.MIIStatus = &value
+		case "link_failure_count":
+			slave.LinkFailureCount = vp.PUInt64()
+		case "perm_hwaddr":
+			slave.PermHWAddr = &value
+		case "queue_id":
+			slave.QueueID = vp.PUInt64()
+		case "ad_aggregator_id":
+			slave.ADAggregatorID = vp.PUInt64()
+		case "ad_actor_oper_port_state":
+			slave.ADActorOperPortState = vp.PUInt64()
+		case "ad_partner_oper_port_state":
+			slave.ADPartnerOperPortState = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	return &slave, nil
+}
+
+// bondOptionName returns the name of a bonding option shown as
+// "<name> <value>", like "802.3ad 4".
+func bondOptionName(value string) *string {
+	name, _, _ := strings.Cut(value, " ")
+	return &name
+}
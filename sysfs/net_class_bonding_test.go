@@ -0,0 +1,169 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBondInterfaces(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Create mock bond0 with two slaves in 802.3ad mode, eth0 not enslaved.
+	files := map[string]string{
+		"class/net/bond0/bonding/mode":             "802.3ad 4",
+		"class/net/bond0/bonding/active_slave":     "",
+		"class/net/bond0/bonding/slaves":           "eth1 eth2",
+		"class/net/bond0/bonding/mii_status":       "up",
+		"class/net/bond0/bonding/miimon":           "100",
+		"class/net/bond0/bonding/lacp_rate":        "fast 1",
+		"class/net/bond0/bonding/xmit_hash_policy": "layer3+4 1",
+		"class/net/bond0/bonding/ad_actor_key":     "15",
+		"class/net/bond0/bonding/ad_partner_key":   "32783",
+		"class/net/bond0/bonding/ad_actor_system":  "00:00:00:00:00:00",
+		"class/net/bond0/bonding/ad_partner_mac":   "3c:fd:fe:aa:bb:cc",
+		"class/net/bond0/bonding/ad_aggregator":    "1",
+		"class/net/bond0/bonding/ad_num_ports":     "2",
+		"class/net/eth0/mtu":                       "1500",
+	}
+	for _, slave := range []string{"eth1", "eth2"} {
+		dir := "class/net/" + slave + "/bonding_slave/"
+		files[dir+"state"] = "active"
+		files[dir+"mii_status"] = "up"
+		files[dir+"link_failure_count"] = "0"
+		files[dir+"queue_id"] = "0"
+		files[dir+"ad_aggregator_id"] = "1"
+		files[dir+"ad_actor_oper_port_state"] = "61"
+		files[dir+"ad_partner_oper_port_state"] = "61"
+	}
+	files["class/net/eth1/bonding_slave/perm_hwaddr"] = "b4:96:91:00:00:01"
+	files["class/net/eth2/bonding_slave/perm_hwaddr"] = "b4:96:91:00:00:02"
+	files["class/net/eth2/bonding_slave/link_failure_count"] = "3"
+
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.BondInterfaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slave := func(name, hwaddr string, linkFailures uint64) BondSlave {
+		return BondSlave{
+			Name:                   name,
+			State:                  makeString("active"),
+			MIIStatus:              makeString("up"),
+			LinkFailureCount:       makeUint64(linkFailures),
+			PermHWAddr:             makeString(hwaddr),
+			QueueID:                makeUint64(0),
+			ADAggregatorID:         makeUint64(1),
+			ADActorOperPortState:   makeUint64(61),
+			ADPartnerOperPortState: makeUint64(61),
+		}
+	}
+	want := Bonds{
+		"bond0": Bond{
+			Name:           "bond0",
+			Mode:           makeString("802.3ad"),
+			Slaves:         []string{"eth1", "eth2"},
+			MIIStatus:      makeString("up"),
+			MIIMon:         makeUint64(100),
+			LACPRate:       makeString("fast"),
+			XmitHashPolicy: makeString("layer3+4"),
+			ADActorKey:     makeUint64(15),
+			ADPartnerKey:   makeUint64(32783),
+			ADActorSystem:  makeString("00:00:00:00:00:00"),
+			ADPartnerMAC:   makeString("3c:fd:fe:aa:bb:cc"),
+			ADAggregator:   makeUint64(1),
+			ADNumPorts:     makeUint64(2),
+			SlaveInfo: map[string]BondSlave{
+				"eth1": slave("eth1", "b4:96:91:00:00:01", 0),
+				"eth2": slave("eth2", "b4:96:91:00:00:02", 3),
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected bonds (-want +got):\n%s", diff)
+	}
+}
+
+func TestBondInterfacesManySlaves(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// 16 slaves make the slaves file longer than 128 bytes.
+	var slaves []string
+	files := map[string]string{
+		"class/net/bond0/bonding/mode": "balance-xor 2",
+	}
+	for i := range 16 {
+		slave := fmt.Sprintf("enp%ds0f0np0", 100+i)
+		slaves = append(slaves, slave)
+		files["class/net/"+slave+"/bonding_slave/state"] = "active"
+	}
+	files["class/net/bond0/bonding/slaves"] = strings.Join(slaves, " ")
+	if n := len(files["class/net/bond0/bonding/slaves"]); n <= 128 {
+		t.Fatalf("slaves file has %d bytes, want more than 128", n)
+	}
+
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.BondInterfaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bond := got["bond0"]
+	if diff := cmp.Diff(slaves, bond.Slaves); diff != "" {
+		t.Fatalf("unexpected slaves (-want +got):\n%s", diff)
+	}
+	for _, slave := range slaves {
+		if diff := cmp.Diff(makeString("active"), bond.SlaveInfo[slave].State); diff != "" {
+			t.Errorf("unexpected state of slave %q (-want +got):\n%s", slave, diff)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// NetBridge contains info from files in /sys/class/net/<bridge>/bridge and
+// the ports in /sys/class/net/<bridge>/brif for a single bridge interface.
+// Times are in hundredths of a second.
+type NetBridge struct {
+	Name          string   // Interface name
+	STPState      *uint64  // /sys/class/net/<bridge>/bridge/stp_state, 0 disabled, 1 kernel, 2 user space STP
+	ForwardDelay  *uint64  // /sys/class/net/<bridge>/bridge/forward_delay
+	HelloTime     *uint64  // /sys/class/net/<bridge>/bridge/hello_time
+	MaxAge        *uint64  // /sys/class/net/<bridge>/bridge/max_age
+	AgeingTime    *uint64  // /sys/class/net/<bridge>/bridge/ageing_time
+	Priority      *uint64  // /sys/class/net/<bridge>/bridge/priority
+	BridgeID      *string  // /sys/class/net/<bridge>/bridge/bridge_id
+	RootID        *string  // /sys/class/net/<bridge>/bridge/root_id
+	RootPort      *uint64  // /sys/class/net/<bridge>/bridge/root_port
+	RootPathCost  *uint64  // /sys/class/net/<bridge>/bridge/root_path_cost
+	VlanFiltering *bool    // /sys/class/net/<bridge>/bridge/vlan_filtering
+	DefaultPVID   *uint64  // /sys/class/net/<bridge>/bridge/default_pvid
+	Ports         []string // /sys/class/net/<bridge>/brif
+}
+
+// NetBridges is collection of info for every bridge interface in /sys/class/net.
+// The map keys are interface (bridge) names.
+type NetBridges map[string]NetBridge
+
+// NetAdjacency contains the stacked devices of a single interface (iface)
+// from the upper_<iface> and lower_<iface> links in /sys/class/net/<iface>,
+// like the VLAN interfaces on top of a NIC or the ports below a bond.
+type NetAdjacency struct {
+	Name   string   // Interface name
+	Uppers []string // /sys/class/net/<iface>/upper_*
+	Lowers []string // /sys/class/net/<iface>/lower_*
+}
+
+// AllNetAdjacency is collection of stacked devices for every interface (iface) in /sys/class/net.
+// The map keys are interface (iface) names.
+type AllNetAdjacency map[string]NetAdjacency
+
+// NetBridges returns info for all bridge interfaces read from
+// /sys/class/net/<bridge>/bridge.
+func (fs FS) NetBridges() (NetBridges, error) {
+	devices, err := fs.NetClassDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	bridges := NetBridges{}
+	for _, devicePath := range devices {
+		path := fs.sys.Path(netclassPath, devicePath)
+		if _, err := os.Stat(filepath.Join(path, "bridge")); os.IsNotExist(err) {
+			continue
+		}
+
+		bridge, err := parseNetBridge(path)
+		if err != nil {
+			return nil, err
+		}
+		bridge.Name = devicePath
+		bridges[devicePath] = *bridge
+	}
+
+	return bridges, nil
+}
+
+func parseNetBridge(path string) (*NetBridge, error) {
+	bridge := NetBridge{}
+
+	bridgePath := filepath.Join(path, "bridge")
+	for _, f := range [...]string{
+		"stp_state", "forward_delay", "hello_time", "max_age", "ageing_time", "priority",
+		"bridge_id", "root_id", "root_port", "root_path_cost", "vlan_filtering", "default_pvid",
+	} {
+		name := filepath.Join(bridgePath, f)
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "stp_state":
+			bridge.STPState = vp.PUInt64()
+		case "forward_delay":
+			bridge.ForwardDelay = vp.PUInt64()
+		case "hello_time":
+			bridge.HelloTime = vp.PUInt64()
+		case "max_age":
+			bridge.MaxAge = vp.PUInt64()
+		case "ageing_time":
+			bridge.AgeingTime = vp.PUInt64()
+		case "priority":
+			bridge.Priority = vp.PUInt64()
+		case "bridge_id":
+			bridge.BridgeID = &value
+		case "root_id":
+			bridge.RootID = &value
+		case "root_port":
+			bridge.RootPort = vp.PUInt64()
+		case "root_path_cost":
+			bridge.RootPathCost = vp.PUInt64()
+		case "vlan_filtering":
+			v := vp.Int() != 0
+			bridge.VlanFiltering = &v
+		case "default_pvid":
+			bridge.DefaultPVID = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	brifPath := filepath.Join(path, "brif")
+	ports, err := os.ReadDir(brifPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to list bridge ports at %q: %w", brifPath, err)
+	}
+	for _, port := range ports {
+		bridge.Ports = append(bridge.Ports, port.Name())
+	}
+
+	return &bridge, nil
+}
+
+// NetAdjacency returns the stacked devices of all net interfaces (iface)
+// read from the upper_* and lower_* links in /sys/class/net/<iface>.
+// Interfaces without any are skipped.
+func (fs FS) NetAdjacency() (AllNetAdjacency, error) {
+	devices, err := fs.NetClassDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	allAdjacency := AllNetAdjacency{}
+	for _, devicePath := range devices {
+		path := fs.sys.Path(netclassPath, devicePath)
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		adjacency := NetAdjacency{Name: devicePath}
+		for _, e := range entries {
+			if upper, ok := strings.CutPrefix(e.Name(), "upper_"); ok {
+				adjacency.Uppers = append(adjacency.Uppers, upper)
+			} else if lower, ok := strings.CutPrefix(e.Name(), "lower_"); ok {
+				adjacency.Lowers = append(adjacency.Lowers, lower)
+			}
+		}
+		if adjacency.Uppers == nil && adjacency.Lowers == nil {
+			continue
+		}
+
+		allAdjacency[devicePath] = adjacency
+	}
+
+	return allAdjacency, nil
+}
@@ -0,0 +1,142 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// writeMockNetTopology creates br0 bridging eth0 and eth0.100, a VLAN on
+// top of eth0.
+func writeMockNetTopology(t *testing.T, root string) {
+	t.Helper()
+
+	files := map[string]string{
+		"class/net/br0/bridge/stp_state":      "1",
+		"class/net/br0/bridge/forward_delay":  "1500",
+		"class/net/br0/bridge/hello_time":     "200",
+		"class/net/br0/bridge/max_age":        "2000",
+		"class/net/br0/bridge/ageing_time":    "30000",
+		"class/net/br0/bridge/priority":       "32768",
+		"class/net/br0/bridge/bridge_id":      "8000.b49691000001",
+		"class/net/br0/bridge/root_id":        "8000.b49691000001",
+		"class/net/br0/bridge/root_port":      "0",
+		"class/net/br0/bridge/root_path_cost": "0",
+		"class/net/br0/bridge/vlan_filtering": "1",
+		"class/net/br0/bridge/default_pvid":   "1",
+		"class/net/eth0/mtu":                  "1500",
+		"class/net/eth0.100/mtu":              "1500",
+		"class/net/eth0/brport/state":         "3",
+		"class/net/eth0.100/brport/state":     "3",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	links := map[string]string{
+		"class/net/br0/brif/eth0":       "../../eth0/brport",
+		"class/net/br0/brif/eth0.100":   "../../eth0.100/brport",
+		"class/net/br0/lower_eth0":      "../eth0",
+		"class/net/br0/lower_eth0.100":  "../eth0.100",
+		"class/net/eth0/upper_br0":      "../br0",
+		"class/net/eth0/upper_eth0.100": "../eth0.100",
+		"class/net/eth0.100/upper_br0":  "../br0",
+		"class/net/eth0.100/lower_eth0": "../eth0",
+	}
+	for name, target := range links {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(target, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNetBridges(t *testing.T) {
+	tempDir := t.TempDir()
+	writeMockNetTopology(t, tempDir)
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NetBridges()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vlanFiltering := true
+	want := NetBridges{
+		"br0": NetBridge{
+			Name:          "br0",
+			STPState:      makeUint64(1),
+			ForwardDelay:  makeUint64(1500),
+			HelloTime:     makeUint64(200),
+			MaxAge:        makeUint64(2000),
+			AgeingTime:    makeUint64(30000),
+			Priority:      makeUint64(32768),
+			BridgeID:      makeString("8000.b49691000001"),
+			RootID:        makeString("8000.b49691000001"),
+			RootPort:      makeUint64(0),
+			RootPathCost:  makeUint64(0),
+			VlanFiltering: &vlanFiltering,
+			DefaultPVID:   makeUint64(1),
+			Ports:         []string{"eth0", "eth0.100"},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected bridges (-want +got):\n%s", diff)
+	}
+}
+
+func TestNetAdjacency(t *testing.T) {
+	tempDir := t.TempDir()
+	writeMockNetTopology(t, tempDir)
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NetAdjacency()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := AllNetAdjacency{
+		"br0":      {Name: "br0", Lowers: []string{"eth0", "eth0.100"}},
+		"eth0":     {Name: "eth0", Uppers: []string{"br0", "eth0.100"}},
+		"eth0.100": {Name: "eth0.100", Uppers: []string{"br0"}, Lowers: []string{"eth0"}},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected adjacency (-want +got):\n%s", diff)
+	}
+}
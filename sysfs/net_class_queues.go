@@ -0,0 +1,244 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// NetRxQueue contains info from files in /sys/class/net/<iface>/queues/rx-<N>
+// for a single receive queue.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-net-queues
+type NetRxQueue struct {
+	RPSCpus    *string // /sys/class/net/<iface>/queues/rx-<N>/rps_cpus, hex CPU mask
+	RPSFlowCnt *uint64 // /sys/class/net/<iface>/queues/rx-<N>/rps_flow_cnt
+}
+
+// NetTxQueue contains info from files in /sys/class/net/<iface>/queues/tx-<N>
+// for a single transmit queue.
+type NetTxQueue struct {
+	XPSCpus         *string             // /sys/class/net/<iface>/queues/tx-<N>/xps_cpus, hex CPU mask
+	XPSRxqs         *string             // /sys/class/net/<iface>/queues/tx-<N>/xps_rxqs, hex receive queue mask
+	TxMaxrate       *uint64             // /sys/class/net/<iface>/queues/tx-<N>/tx_maxrate, in Mbps
+	TxTimeout       *uint64             // /sys/class/net/<iface>/queues/tx-<N>/tx_timeout
+	ByteQueueLimits *NetByteQueueLimits // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits
+}
+
+// NetByteQueueLimits contains the byte queue limits (BQL) state of a
+// transmit queue from files in
+// /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits .
+type NetByteQueueLimits struct {
+	HoldTime *uint64 // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits/hold_time, in milliseconds
+	Inflight *uint64 // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits/inflight
+	Limit    *uint64 // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits/limit
+	LimitMax *uint64 // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits/limit_max
+	LimitMin *uint64 // /sys/class/net/<iface>/queues/tx-<N>/byte_queue_limits/limit_min
+}
+
+// NetQueueInfo contains the receive and transmit queues in
+// /sys/class/net/<iface>/queues for single interface (iface).
+//
+// The map keys are the queue directory names, like "rx-0" and "tx-0".
+type NetQueueInfo struct {
+	Name string // Interface name
+	Rx   map[string]NetRxQueue
+	Tx   map[string]NetTxQueue
+}
+
+// AllNetQueueInfo is collection of queue info for every interface (iface) in /sys/class/net.
+// The map keys are interface (iface) names.
+type AllNetQueueInfo map[string]NetQueueInfo
+
+// NetQueuesByIface returns the queue info for a single net interface (iface).
+func (fs FS) NetQueuesByIface(devicePath string) (*NetQueueInfo, error) {
+	info, err := parseNetQueues(fs.sys.Path(netclassPath, devicePath, "queues"))
+	if err != nil {
+		return nil, err
+	}
+	info.Name = devicePath
+
+	return info, nil
+}
+
+// NetQueues returns the queue info for all net interfaces (iface) read from
+// /sys/class/net/<iface>/queues. Interfaces without queues are skipped.
+func (fs FS) NetQueues() (AllNetQueueInfo, error) {
+	devices, err := fs.NetClassDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	allInfo := AllNetQueueInfo{}
+	for _, devicePath := range devices {
+		path := fs.sys.Path(netclassPath, devicePath, "queues")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+
+		info, err := parseNetQueues(path)
+		if err != nil {
+			return nil, err
+		}
+		info.Name = devicePath
+		allInfo[devicePath] = *info
+	}
+
+	return allInfo, nil
+}
+
+func parseNetQueues(path string) (*NetQueueInfo, error) {
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := NetQueueInfo{
+		Rx: map[string]NetRxQueue{},
+		Tx: map[string]NetTxQueue{},
+	}
+	for _, d := range dirs {
+		queuePath := filepath.Join(path, d.Name())
+		switch {
+		case strings.HasPrefix(d.Name(), "rx-"):
+			queue, err := parseNetRxQueue(queuePath)
+			if err != nil {
+				return nil, err
+			}
+			info.Rx[d.Name()] = *queue
+		case strings.HasPrefix(d.Name(), "tx-"):
+			queue, err := parseNetTxQueue(queuePath)
+			if err != nil {
+				return nil, err
+			}
+			info.Tx[d.Name()] = *queue
+		}
+	}
+
+	return &info, nil
+}
+
+func parseNetRxQueue(path string) (*NetRxQueue, error) {
+	queue := NetRxQueue{}
+
+	for _, f := range [...]string{"rps_cpus", "rps_flow_cnt"} {
+		name := filepath.Join(path, f)
+		value, err := readNetQueueFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "rps_cpus":
+			queue.RPSCpus = &value
+		case "rps_flow_cnt":
+			queue.RPSFlowCnt = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	return &queue, nil
+}
+
+func parseNetTxQueue(path string) (*NetTxQueue, error) {
+	queue := NetTxQueue{}
+
+	for _, f := range [...]string{"xps_cpus", "xps_rxqs", "tx_maxrate", "tx_timeout"} {
+		name := filepath.Join(path, f)
+		value, err := readNetQueueFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "xps_cpus":
+			queue.XPSCpus = &value
+		case "xps_rxqs":
+			queue.XPSRxqs = &value
+		case "tx_maxrate":
+			queue.TxMaxrate = vp.PUInt64()
+		case "tx_timeout":
+			queue.TxTimeout = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	bqlPath := filepath.Join(path, "byte_queue_limits")
+	if _, err := os.Stat(bqlPath); os.IsNotExist(err) {
+		return &queue, nil
+	}
+
+	bql := NetByteQueueLimits{}
+	for _, f := range [...]string{"hold_time", "inflight", "limit", "limit_max", "limit_min"} {
+		name := filepath.Join(bqlPath, f)
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "hold_time":
+			bql.HoldTime = vp.PUInt64()
+		case "inflight":
+			bql.Inflight = vp.PUInt64()
+		case "limit":
+			bql.Limit = vp.PUInt64()
+		case "limit_max":
+			bql.LimitMax = vp.PUInt64()
+		case "limit_min":
+			bql.LimitMin = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+	queue.ByteQueueLimits = &bql
+
+	return &queue, nil
+}
+
+// readNetQueueFile reads a queue attribute. Unlike util.SysReadFile it is not
+// limited to 128 bytes, as CPU masks of hosts with many CPUs are longer.
+func readNetQueueFile(name string) (string, error) {
+	data, err := util.ReadFileNoStat(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,72 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNetQueues(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NetQueues()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bql := &NetByteQueueLimits{
+		HoldTime: makeUint64(1000),
+		Inflight: makeUint64(0),
+		Limit:    makeUint64(30280),
+		LimitMax: makeUint64(1879048192),
+		LimitMin: makeUint64(0),
+	}
+	want := AllNetQueueInfo{
+		"eth0": NetQueueInfo{
+			Name: "eth0",
+			Rx: map[string]NetRxQueue{
+				"rx-0": {RPSCpus: makeString("00000000,00000003"), RPSFlowCnt: makeUint64(4096)},
+				// 512 CPUs, the mask is longer than 128 bytes.
+				"rx-1": {RPSCpus: makeString("80000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,00000000,0000000c"), RPSFlowCnt: makeUint64(0)},
+			},
+			Tx: map[string]NetTxQueue{
+				"tx-0": {
+					XPSCpus:         makeString("00000000,00000001"),
+					XPSRxqs:         makeString("1"),
+					TxMaxrate:       makeUint64(0),
+					TxTimeout:       makeUint64(0),
+					ByteQueueLimits: bql,
+				},
+				"tx-1": {
+					XPSCpus:         makeString("00000000,00000002"),
+					XPSRxqs:         makeString("2"),
+					TxMaxrate:       makeUint64(10000),
+					TxTimeout:       makeUint64(3),
+					ByteQueueLimits: bql,
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected net queues (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// NetClassStatistics contains the interface counters from files in
+// /sys/class/net/<iface>/statistics for single interface (iface).
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-net-statistics
+type NetClassStatistics struct {
+	Name              string  // Interface name
+	RxBytes           *uint64 // /sys/class/net/<iface>/statistics/rx_bytes
+	RxPackets         *uint64 // /sys/class/net/<iface>/statistics/rx_packets
+	RxErrors          *uint64 // /sys/class/net/<iface>/statistics/rx_errors
+	RxDropped         *uint64 // /sys/class/net/<iface>/statistics/rx_dropped
+	RxMissedErrors    *uint64 // /sys/class/net/<iface>/statistics/rx_missed_errors
+	RxFifoErrors      *uint64 // /sys/class/net/<iface>/statistics/rx_fifo_errors
+	RxLengthErrors    *uint64 // /sys/class/net/<iface>/statistics/rx_length_errors
+	RxOverErrors      *uint64 // /sys/class/net/<iface>/statistics/rx_over_errors
+	RxCrcErrors       *uint64 // /sys/class/net/<iface>/statistics/rx_crc_errors
+	RxFrameErrors     *uint64 // /sys/class/net/<iface>/statistics/rx_frame_errors
+	RxCompressed      *uint64 // /sys/class/net/<iface>/statistics/rx_compressed
+	RxNohandler       *uint64 // /sys/class/net/<iface>/statistics/rx_nohandler
+	Multicast         *uint64 // /sys/class/net/<iface>/statistics/multicast
+	TxBytes           *uint64 // /sys/class/net/<iface>/statistics/tx_bytes
+	TxPackets         *uint64 // /sys/class/net/<iface>/statistics/tx_packets
+	TxErrors          *uint64 // /sys/class/net/<iface>/statistics/tx_errors
+	TxDropped         *uint64 // /sys/class/net/<iface>/statistics/tx_dropped
+	TxFifoErrors      *uint64 // /sys/class/net/<iface>/statistics/tx_fifo_errors
+	TxAbortedErrors   *uint64 // /sys/class/net/<iface>/statistics/tx_aborted_errors
+	TxCarrierErrors   *uint64 // /sys/class/net/<iface>/statistics/tx_carrier_errors
+	TxHeartbeatErrors *uint64 // /sys/class/net/<iface>/statistics/tx_heartbeat_errors
+	TxWindowErrors    *uint64 // /sys/class/net/<iface>/statistics/tx_window_errors
+	TxCompressed      *uint64 // /sys/class/net/<iface>/statistics/tx_compressed
+	Collisions        *uint64 // /sys/class/net/<iface>/statistics/collisions
+}
+
+// AllNetClassStatistics is collection of statistics for every interface (iface) in /sys/class/net.
+// The map keys are interface (iface) names.
+type AllNetClassStatistics map[string]NetClassStatistics
+
+// NetClassStatisticsByIface returns the statistics for a single net interface (iface).
+func (fs FS) NetClassStatisticsByIface(devicePath string) (*NetClassStatistics, error) {
+	path := fs.sys.Path(netclassPath, devicePath, "statistics")
+
+	stats, err := parseNetClassStatistics(path)
+	if err != nil {
+		return nil, err
+	}
+	stats.Name = devicePath
+
+	return stats, nil
+}
+
+// NetClassStatistics returns the statistics for all net interfaces (iface) read from
+// /sys/class/net/<iface>/statistics. Interfaces without statistics are skipped.
+func (fs FS) NetClassStatistics() (AllNetClassStatistics, error) {
+	devices, err := fs.NetClassDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	allStats := AllNetClassStatistics{}
+	for _, devicePath := range devices {
+		path := fs.sys.Path(netclassPath, devicePath, "statistics")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+
+		stats, err := parseNetClassStatistics(path)
+		if err != nil {
+			return nil, err
+		}
+		stats.Name = devicePath
+		allStats[devicePath] = *stats
+	}
+
+	return allStats, nil
+}
+
+// parseNetClassStatistics reads the counter files in
+// /sys/class/net/<iface>/statistics. Counters the driver does not provide
+// are left nil.
+func parseNetClassStatistics(path string) (*NetClassStatistics, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := NetClassStatistics{}
+	for _, f := range files {
+		if !f.Type().IsRegular() {
+			continue
+		}
+
+		name := filepath.Join(path, f.Name())
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if canIgnoreError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		vp := util.NewValueParser(value)
+		switch f.Name() {
+		case "rx_bytes":
+			stats.RxBytes = vp.PUInt64()
+		case "rx_packets":
+			stats.RxPackets = vp.PUInt64()
+		case "rx_errors":
+			stats.RxErrors = vp.PUInt64()
+		case "rx_dropped":
+			stats.RxDropped = vp.PUInt64()
+		case "rx_missed_errors":
+			stats.RxMissedErrors = vp.PUInt64()
+		case "rx_fifo_errors":
+			stats.RxFifoErrors = vp.PUInt64()
+		case "rx_length_errors":
+			stats.RxLengthErrors = vp.PUInt64()
+		case "rx_over_errors":
+			stats.RxOverErrors = vp.PUInt64()
+		case "rx_crc_errors":
+			stats.RxCrcErrors = vp.PUInt64()
+		case "rx_frame_errors":
+			stats.RxFrameErrors = vp.PUInt64()
+		case "rx_compressed":
+			stats.RxCompressed = vp.PUInt64()
+		case "rx_nohandler":
+			stats.RxNohandler = vp.PUInt64()
+		case "multicast":
+			stats.Multicast = vp.PUInt64()
+		case "tx_bytes":
+			stats.TxBytes = vp.PUInt64()
+		case "tx_packets":
+			stats.TxPackets = vp.PUInt64()
+		case "tx_errors":
+			stats.TxErrors = vp.PUInt64()
+		case "tx_dropped":
+			stats.TxDropped = vp.PUInt64()
+		case "tx_fifo_errors":
+			stats.TxFifoErrors = vp.PUInt64()
+		case "tx_aborted_errors":
+			stats.TxAbortedErrors = vp.PUInt64()
+		case "tx_carrier_errors":
+			stats.TxCarrierErrors = vp.PUInt64()
+		case "tx_heartbeat_errors":
+			stats.TxHeartbeatErrors = vp.PUInt64()
+		case "tx_window_errors":
+			stats.TxWindowErrors = vp.PUInt64()
+		case "tx_compressed":
+			stats.TxCompressed = vp.PUInt64()
+		case "collisions":
+			stats.Collisions = vp.PUInt64()
+		default:
+			continue
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,76 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNetClassStatistics(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NetClassStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := AllNetClassStatistics{
+		"eth0": NetClassStatistics{
+			Name:              "eth0",
+			Collisions:        makeUint64(1),
+			Multicast:         makeUint64(2),
+			RxBytes:           makeUint64(3),
+			RxCompressed:      makeUint64(4),
+			RxCrcErrors:       makeUint64(5),
+			RxDropped:         makeUint64(6),
+			RxErrors:          makeUint64(7),
+			RxFifoErrors:      makeUint64(8),
+			RxFrameErrors:     makeUint64(9),
+			RxLengthErrors:    makeUint64(10),
+			RxMissedErrors:    makeUint64(11),
+			RxNohandler:       makeUint64(12),
+			RxOverErrors:      makeUint64(13),
+			RxPackets:         makeUint64(14),
+			TxAbortedErrors:   makeUint64(15),
+			TxBytes:           makeUint64(16),
+			TxCarrierErrors:   makeUint64(17),
+			TxCompressed:      makeUint64(18),
+			TxDropped:         makeUint64(19),
+			TxErrors:          makeUint64(20),
+			TxFifoErrors:      makeUint64(21),
+			TxHeartbeatErrors: makeUint64(22),
+			TxPackets:         makeUint64(23),
+			TxWindowErrors:    makeUint64(24),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected net class statistics (-want +got):\n%s", diff)
+	}
+
+	eth0, err := fs.NetClassStatisticsByIface("eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want["eth0"], *eth0); diff != "" {
+		t.Fatalf("unexpected eth0 statistics (-want +got):\n%s", diff)
+	}
+}
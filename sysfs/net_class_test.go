@@ -80,6 +80,7 @@ func TestNetClass(t *testing.T) {
 		carrierDownCount int64 = 1
 		carrierUpCount   int64 = 1
 		devID            int64 = 32
+		devPort          int64 = 1
 		dormant          int64 = 1
 		flags            int64 = 4867
 		ifIndex          int64 = 2
@@ -104,6 +105,7 @@ func TestNetClass(t *testing.T) {
 			CarrierDownCount: &carrierDownCount,
 			CarrierUpCount:   &carrierUpCount,
 			DevID:            &devID,
+			DevPort:          &devPort,
 			Dormant:          &dormant,
 			Duplex:           "full",
 			Flags:            &flags,
@@ -117,8 +119,8 @@ func TestNetClass(t *testing.T) {
 			NetDevGroup:      &netDevGroup,
 			OperState:        "up",
 			PhysPortID:       "",
-			PhysPortName:     "",
-			PhysSwitchID:     "",
+			PhysPortName:     "p0",
+			PhysSwitchID:     "0aeb3d0003fe0b1c",
 			Speed:            &speed,
 			TxQueueLen:       &txQueueLen,
 			Type:             &netType,
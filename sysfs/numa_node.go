@@ -0,0 +1,238 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// NumaNodeHugepages contains the hugepage pool counters of a single page
+// size on a NUMA node, from
+// /sys/devices/system/node/node<ID>/hugepages/hugepages-<Size>kB .
+type NumaNodeHugepages struct {
+	Total   uint64 // nr_hugepages
+	Free    uint64 // free_hugepages
+	Surplus uint64 // surplus_hugepages
+}
+
+// NumaNode contains info from files in /sys/devices/system/node/node<ID> for
+// a single NUMA node.
+// https://www.kernel.org/doc/Documentation/ABI/stable/sysfs-devices-node
+type NumaNode struct {
+	ID   int
+	CPUs []uint16 // /sys/devices/system/node/node<ID>/cpulist
+	// Distances holds the relative access distance to every node, keyed by
+	// node ID. /sys/devices/system/node/node<ID>/distance
+	Distances map[int]uint64
+	// Meminfo holds the per-node memory counters keyed by field name, e.g.
+	// "MemFree". Values with a kB unit are converted to bytes.
+	// /sys/devices/system/node/node<ID>/meminfo
+	Meminfo map[string]uint64
+	// Numastat holds the allocation hit/miss counters keyed by field name.
+	// /sys/devices/system/node/node<ID>/numastat
+	Numastat map[string]uint64
+	// Hugepages holds the hugepage pools keyed by page size in bytes.
+	// /sys/devices/system/node/node<ID>/hugepages
+	Hugepages map[uint64]NumaNodeHugepages
+}
+
+// NumaNodes is a collection of every NUMA node in /sys/devices/system/node .
+//
+// The map keys are the node IDs.
+type NumaNodes map[int]NumaNode
+
+// NumaNodes returns info for all NUMA nodes read from
+// /sys/devices/system/node .
+func (fs FS) NumaNodes() (NumaNodes, error) {
+	nodes, err := filepath.Glob(fs.sys.Path(nodePattern))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(nodes))
+	for _, node := range nodes {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(node), "node"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NUMA node %q: %w", node, err)
+		}
+		ids = append(ids, id)
+	}
+	// The distance file lists one entry per online node in ascending ID order.
+	slices.Sort(ids)
+
+	numaNodes := make(NumaNodes, len(ids))
+	for _, id := range ids {
+		node, err := fs.parseNumaNode(id, ids)
+		if err != nil {
+			return nil, err
+		}
+		numaNodes[id] = *node
+	}
+
+	return numaNodes, nil
+}
+
+func (fs FS) parseNumaNode(id int, ids []int) (*NumaNode, error) {
+	path := fs.sys.Path("devices/system/node", "node"+strconv.Itoa(id))
+	node := &NumaNode{ID: id}
+
+	for _, f := range [...]string{"cpulist", "distance", "meminfo", "numastat"} {
+		name := filepath.Join(path, f)
+		data, err := util.ReadFileNoStat(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		switch f {
+		case "cpulist":
+			node.CPUs, err = parseCPURange(data)
+		case "distance":
+			node.Distances, err = parseNumaNodeDistance(string(data), ids)
+		case "meminfo":
+			node.Meminfo, err = parseNumaNodeMeminfo(string(data))
+		case "numastat":
+			node.Numastat, err = parseNumaNodeNumastat(string(data))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	hugepages, err := parseNumaNodeHugepages(filepath.Join(path, "hugepages"))
+	if err != nil {
+		return nil, err
+	}
+	node.Hugepages = hugepages
+
+	return node, nil
+}
+
+func parseNumaNodeDistance(data string, ids []int) (map[int]uint64, error) {
+	fields := strings.Fields(data)
+	if len(fields) != len(ids) {
+		return nil, fmt.Errorf("expected %d distances, got %d", len(ids), len(fields))
+	}
+
+	distances := make(map[int]uint64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid distance %q: %w", f, err)
+		}
+		distances[ids[i]] = v
+	}
+
+	return distances, nil
+}
+
+// parseNumaNodeMeminfo parses lines like "Node 0 MemFree:  8273196 kB".
+func parseNumaNodeMeminfo(data string) (map[string]uint64, error) {
+	meminfo := map[string]uint64{}
+	for line := range strings.SplitSeq(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 4 || fields[0] != "Node" {
+			return nil, fmt.Errorf("malformed meminfo line: %q", line)
+		}
+
+		v, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in meminfo line %q: %w", line, err)
+		}
+		if len(fields) == 5 && fields[4] == "kB" {
+			v *= 1024
+		}
+		meminfo[strings.TrimSuffix(fields[2], ":")] = v
+	}
+
+	return meminfo, nil
+}
+
+func parseNumaNodeNumastat(data string) (map[string]uint64, error) {
+	numastat := map[string]uint64{}
+	for line := range strings.SplitSeq(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed numastat line: %q", line)
+		}
+
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in numastat line %q: %w", line, err)
+		}
+		numastat[fields[0]] = v
+	}
+
+	return numastat, nil
+}
+
+func parseNumaNodeHugepages(path string) (map[uint64]NumaNodeHugepages, error) {
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list hugepages at %q: %w", path, err)
+	}
+
+	hugepages := make(map[uint64]NumaNodeHugepages, len(dirs))
+	for _, d := range dirs {
+		sizeStr, ok := strings.CutPrefix(d.Name(), "hugepages-")
+		if !ok {
+			continue
+		}
+		size, err := strconv.ParseUint(strings.TrimSuffix(sizeStr, "kB"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse hugepage size %q: %w", d.Name(), err)
+		}
+
+		var pool NumaNodeHugepages
+		for _, f := range [...]string{"nr_hugepages", "free_hugepages", "surplus_hugepages"} {
+			name := filepath.Join(path, d.Name(), f)
+			value, err := util.ReadUintFromFile(name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+			}
+
+			switch f {
+			case "nr_hugepages":
+				pool.Total = value
+			case "free_hugepages":
+				pool.Free = value
+			case "surplus_hugepages":
+				pool.Surplus = value
+			}
+		}
+		hugepages[size*1024] = pool
+	}
+
+	return hugepages, nil
+}
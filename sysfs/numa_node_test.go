@@ -0,0 +1,88 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNumaNodes(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.NumaNodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hugepages := map[uint64]NumaNodeHugepages{
+		2097152:    {Total: 512, Free: 500, Surplus: 0},
+		1073741824: {Total: 2, Free: 1, Surplus: 0},
+	}
+	meminfo := map[string]uint64{
+		"MemTotal":        16729694208,
+		"MemFree":         8471752704,
+		"MemUsed":         8257941504,
+		"Active":          4221886464,
+		"Inactive":        2961584128,
+		"Dirty":           110592,
+		"AnonHugePages":   0,
+		"HugePages_Total": 512,
+		"HugePages_Free":  500,
+		"HugePages_Surp":  0,
+	}
+
+	want := NumaNodes{
+		1: {
+			ID:        1,
+			CPUs:      []uint16{0, 1, 2, 3},
+			Distances: map[int]uint64{1: 10, 2: 21},
+			Meminfo:   meminfo,
+			Numastat: map[string]uint64{
+				"numa_hit":       11,
+				"numa_miss":      21,
+				"numa_foreign":   31,
+				"interleave_hit": 41,
+				"local_node":     51,
+				"other_node":     61,
+			},
+			Hugepages: hugepages,
+		},
+		2: {
+			ID:        2,
+			CPUs:      []uint16{4, 5, 6, 7},
+			Distances: map[int]uint64{1: 21, 2: 10},
+			Meminfo:   meminfo,
+			Numastat: map[string]uint64{
+				"numa_hit":       12,
+				"numa_miss":      22,
+				"numa_foreign":   32,
+				"interleave_hit": 42,
+				"local_node":     52,
+				"other_node":     62,
+			},
+			Hugepages: hugepages,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected NumaNodes (-want +got):\n%s", diff)
+	}
+}
@@ -16,11 +16,17 @@
 package sysfs
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
 
 	"github.com/prometheus/procfs/internal/util"
 )
@@ -54,10 +60,20 @@ type PciDeviceLocation struct {
 	Function int
 }
 
+// String returns the location in the "0000:00:00:0" format used as the
+// default PciDevices map key.
 func (pdl PciDeviceLocation) String() string {
 	return fmt.Sprintf("%04x:%02x:%02x:%x", pdl.Segment, pdl.Bus, pdl.Device, pdl.Function)
 }
 
+// DirectoryName returns the location in the canonical "0000:00:00.0" format
+// used by the kernel for the device directory in /sys/bus/pci/devices and
+// printed by lspci.
+func (pdl PciDeviceLocation) DirectoryName() string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", pdl.Segment, pdl.Bus, pdl.Device, pdl.Function)
+}
+
+// Strings returns the segment, bus, device and function as hex strings.
 func (pdl PciDeviceLocation) Strings() []string {
 	return []string{
 		fmt.Sprintf("%04x", pdl.Segment),
@@ -97,6 +113,35 @@ type PciDevice struct {
 
 	D3coldAllowed *bool          // /sys/bus/pci/devices/<Location>/d3cold_allowed
 	PowerState    *PciPowerState // /sys/bus/pci/devices/<Location>/power_state
+
+	IommuGroup *int // /sys/bus/pci/devices/<Location>/iommu_group
+
+	PhysFn *PciDeviceLocation // /sys/bus/pci/devices/<Location>/physfn
+
+	LinkPM *PciLinkPM // /sys/bus/pci/devices/<Location>/link
+
+	SerialNumber *uint64 // /sys/bus/pci/devices/<Location>/config
+	ACS          *PciACS // /sys/bus/pci/devices/<Location>/config
+
+	Bridge *PciBridgeInfo // only set for PCI-to-PCI bridges
+
+	BootVGA *bool   // /sys/bus/pci/devices/<Location>/boot_vga
+	Label   *string // /sys/bus/pci/devices/<Location>/label
+	Index   *uint32 // /sys/bus/pci/devices/<Location>/index
+	HasROM  bool    // /sys/bus/pci/devices/<Location>/rom exists
+
+	Uevent *PciUevent // /sys/bus/pci/devices/<Location>/uevent
+}
+
+// PciLinkPM contains the PCIe link power management (ASPM) state from files
+// in /sys/bus/pci/devices/<Location>/link .
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-bus-pci
+type PciLinkPM struct {
+	L0sASPM *bool // /sys/bus/pci/devices/<Location>/link/l0s_aspm
+	L1ASPM  *bool // /sys/bus/pci/devices/<Location>/link/l1_aspm
+	L11ASPM *bool // /sys/bus/pci/devices/<Location>/link/l1_1_aspm
+	L12ASPM *bool // /sys/bus/pci/devices/<Location>/link/l1_2_aspm
+	ClkPM   *bool // /sys/bus/pci/devices/<Location>/link/clkpm
 }
 
 func (pd PciDevice) Name() string {
@@ -112,6 +157,41 @@ type PciDevices map[string]PciDevice
 // PciDevices returns info for all PCI devices read from
 // /sys/bus/pci/devices .
 func (fs FS) PciDevices() (PciDevices, error) {
+	return fs.PciDevicesWithFilter(PciFilter{})
+}
+
+// PciDevicesWithFilter returns info for the PCI devices read from
+// /sys/bus/pci/devices that match the given filter. Devices that do not
+// match are skipped before their remaining attributes are parsed.
+func (fs FS) PciDevicesWithFilter(filter PciFilter) (PciDevices, error) {
+	return fs.PciDevicesWithOptions(PciDevicesOptions{Filter: filter})
+}
+
+// PciDevicesOptions configures how PciDevicesWithOptions reads
+// /sys/bus/pci/devices .
+type PciDevicesOptions struct {
+	// Filter selects the devices to parse.
+	Filter PciFilter
+	// Workers is the maximum number of devices parsed concurrently.
+	// Values less than 2 parse devices sequentially.
+	Workers int
+	// CanonicalKeys keys the returned map by the canonical "0000:00:00.0"
+	// location (see PciDeviceLocation.DirectoryName) instead of the
+	// default "0000:00:00:0" format.
+	CanonicalKeys bool
+	// Lenient skips devices that fail to parse instead of aborting the
+	// whole scan. The successfully parsed devices are returned together
+	// with an error joining one error per skipped device.
+	Lenient bool
+}
+
+// PciDevicesWithOptions returns info for the PCI devices read from
+// /sys/bus/pci/devices according to the given options.
+//
+// In lenient mode both the parsed devices and a non-nil error may be
+// returned; the per-device errors are available through the
+// Unwrap() []error method of the returned error.
+func (fs FS) PciDevicesWithOptions(opts PciDevicesOptions) (PciDevices, error) {
 	path := fs.sys.Path(pciDevicesPath)
 
 	dirs, err := os.ReadDir(path)
@@ -119,20 +199,166 @@ func (fs FS) PciDevices() (PciDevices, error) {
 		return nil, err
 	}
 
+	// Each worker writes to its own slot so the result does not depend on
+	// the order in which devices finish parsing.
+	devices := make([]*PciDevice, len(dirs))
+	deviceErrs := make([]error, len(dirs))
+
+	var g errgroup.Group
+	g.SetLimit(max(opts.Workers, 1))
+	for i, d := range dirs {
+		g.Go(func() error {
+			device, err := fs.parsePciDeviceWithFilter(d.Name(), opts.Filter)
+			if err != nil {
+				if opts.Lenient {
+					deviceErrs[i] = fmt.Errorf("failed to parse PCI device %q: %w", d.Name(), err)
+					return nil
+				}
+				return err
+			}
+
+			devices[i] = device
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
 	pciDevs := make(PciDevices, len(dirs))
-	for _, d := range dirs {
-		device, err := fs.parsePciDevice(d.Name())
-		if err != nil {
+	for _, device := range devices {
+		if device == nil {
+			continue
+		}
+		key := device.Name()
+		if opts.CanonicalKeys {
+			key = device.Location.DirectoryName()
+		}
+		pciDevs[key] = *device
+	}
+
+	return pciDevs, errors.Join(deviceErrs...)
+}
+
+// IterPciDevices calls fn for each PCI device read from /sys/bus/pci/devices
+// in directory order. Devices are parsed one at a time, so unlike
+// PciDevices the whole collection is never held in memory. Iteration stops
+// at the first error returned by fn or encountered while parsing a device,
+// and that error is returned.
+func (fs FS) IterPciDevices(fn func(PciDevice) error) error {
+	return fs.IterPciDevicesWithOptions(PciDevicesOptions{}, fn)
+}
+
+// IterPciDevicesWithOptions is like IterPciDevices, but only visits the
+// devices matching opts.Filter. With opts.Workers above 1 devices are parsed
+// concurrently and fn is called in completion order, though never
+// concurrently. In lenient mode devices that fail to parse are skipped and
+// their errors are joined into the returned error. opts.CanonicalKeys is
+// ignored.
+func (fs FS) IterPciDevicesWithOptions(opts PciDevicesOptions, fn func(PciDevice) error) error {
+	return fs.iterPciDevices(opts, func(name string) (func() error, error) {
+		device, err := fs.parsePciDeviceWithFilter(name, opts.Filter)
+		if err != nil || device == nil {
 			return nil, err
 		}
+		return func() error { return fn(*device) }, nil
+	})
+}
+
+// iterPciDevices calls parse for each device in /sys/bus/pci/devices,
+// following the Workers and Lenient semantics of PciDevicesOptions. parse
+// returns the function passing the parsed device on, or nil to skip the
+// device. The returned functions are never called concurrently.
+func (fs FS) iterPciDevices(opts PciDevicesOptions, parse func(name string) (func() error, error)) error {
+	path := fs.sys.Path(pciDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu         sync.Mutex
+		deviceErrs []error
+	)
+
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(max(opts.Workers, 1))
+	for _, d := range dirs {
+		g.Go(func() error {
+			// Stop parsing the remaining devices once one has failed.
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			emit, err := parse(d.Name())
+			if err != nil {
+				if opts.Lenient {
+					mu.Lock()
+					deviceErrs = append(deviceErrs, fmt.Errorf("failed to parse PCI device %q: %w", d.Name(), err))
+					mu.Unlock()
+					return nil
+				}
+				return err
+			}
+			if emit == nil {
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			return emit()
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	return errors.Join(deviceErrs...)
+}
+
+// PciDevicesByNumaNode returns info for all PCI devices read from
+// /sys/bus/pci/devices grouped by NUMA node. Devices without NUMA affinity,
+// which report a numa_node of -1 or have no numa_node file, are grouped
+// under -1. Each group is sorted by device location.
+func (fs FS) PciDevicesByNumaNode() (map[int32][]PciDevice, error) {
+	devices, err := fs.PciDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := map[int32][]PciDevice{}
+	for _, device := range devices {
+		node := int32(-1)
+		if device.NumaNode != nil {
+			node = *device.NumaNode
+		}
+		nodes[node] = append(nodes[node], device)
+	}
+
+	for _, nodeDevices := range nodes {
+		sort.Slice(nodeDevices, func(i, j int) bool {
+			return nodeDevices[i].Location.DirectoryName() < nodeDevices[j].Location.DirectoryName()
+		})
+	}
+
+	return nodes, nil
+}
 
-		pciDevs[device.Name()] = *device
+// parsePciDeviceWithFilter parses the named device if it matches the filter.
+// It returns nil if the device does not match.
+func (fs FS) parsePciDeviceWithFilter(name string, filter PciFilter) (*PciDevice, error) {
+	ok, err := fs.pciDeviceMatches(name, filter)
+	if err != nil || !ok {
+		return nil, err
 	}
 
-	return pciDevs, nil
+	return fs.parsePciDevice(name)
 }
 
-func parsePciDeviceLocation(loc string) (*PciDeviceLocation, error) {
+// ParsePciDeviceLocation parses a PCI device location in the canonical
+// "0000:00:00.0" Segment:Bus:Device.Function format.
+func ParsePciDeviceLocation(loc string) (*PciDeviceLocation, error) {
 	locs := strings.Split(loc, ":")
 	if len(locs) != 3 {
 		return nil, fmt.Errorf("invalid location '%s'", loc)
@@ -182,9 +408,9 @@ func (fs FS) parsePciDevice(name string) (*PciDevice, error) {
 	deviceLocStr := filepath.Base(realPath)
 	parentDeviceLocStr := filepath.Base(filepath.Dir(realPath))
 
-	deviceLoc, err := parsePciDeviceLocation(deviceLocStr)
+	deviceLoc, err := ParsePciDeviceLocation(deviceLocStr)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse device location:%q %w", deviceLoc, err)
+		return nil, fmt.Errorf("failed to parse device location %q: %w", deviceLocStr, err)
 	}
 
 	// the parent device may have "pci" prefix.
@@ -193,7 +419,7 @@ func (fs FS) parsePciDevice(name string) (*PciDevice, error) {
 	// TODO: is it really ok?
 	var parentDeviceLoc *PciDeviceLocation
 	if !strings.HasPrefix(parentDeviceLocStr, "pci") {
-		parentDeviceLoc, err = parsePciDeviceLocation(parentDeviceLocStr)
+		parentDeviceLoc, err = ParsePciDeviceLocation(parentDeviceLocStr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse parent device location %q: %w", parentDeviceLocStr, err)
 		}
@@ -234,6 +460,13 @@ func (fs FS) parsePciDevice(name string) (*PciDevice, error) {
 		}
 	}
 
+	if isPciBridge(device.Class) {
+		device.Bridge, err = parsePciBridgeInfo(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bridge info %s: %w", device.Location, err)
+		}
+	}
+
 	for _, f := range [...]string{"max_link_speed", "max_link_width", "current_link_speed", "current_link_width", "numa_node"} {
 		name := filepath.Join(path, f)
 		valueStr, err := util.SysReadFile(name)
@@ -404,5 +637,185 @@ func (fs FS) parsePciDevice(name string) (*PciDevice, error) {
 		}
 	}
 
+	// Parse platform attributes (boot_vga only exists for VGA devices,
+	// label and index only when provided by ACPI or SMBIOS)
+	for _, f := range [...]string{"boot_vga", "label", "index"} {
+		name := filepath.Join(path, f)
+		valueStr, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q %s: %w", name, device.Location, err)
+		}
+
+		switch f {
+		case "boot_vga":
+			value, err := strconv.ParseInt(valueStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse boot_vga %q %s: %w", valueStr, device.Location, err)
+			}
+			v := value != 0
+			device.BootVGA = &v
+
+		case "label":
+			device.Label = &valueStr
+
+		case "index":
+			value, err := strconv.ParseUint(valueStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse index %q %s: %w", valueStr, device.Location, err)
+			}
+			v := uint32(value)
+			device.Index = &v
+		}
+	}
+
+	// The rom file is only readable by root, so only check for its presence.
+	if _, err := os.Lstat(filepath.Join(path, "rom")); err == nil {
+		device.HasROM = true
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to stat rom %s: %w", device.Location, err)
+	}
+
+	// Parse ASPM link state files (these only exist for devices whose
+	// link supports the corresponding states)
+	var linkPM PciLinkPM
+	for _, f := range [...]string{"l0s_aspm", "l1_aspm", "l1_1_aspm", "l1_2_aspm", "clkpm"} {
+		name := filepath.Join(path, "link", f)
+		valueStr, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read link PM file %q %s: %w", name, device.Location, err)
+		}
+
+		value, err := strconv.ParseInt(valueStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s %q %s: %w", f, valueStr, device.Location, err)
+		}
+		v := value != 0
+		device.LinkPM = &linkPM
+
+		switch f {
+		case "l0s_aspm":
+			linkPM.L0sASPM = &v
+		case "l1_aspm":
+			linkPM.L1ASPM = &v
+		case "l1_1_aspm":
+			linkPM.L11ASPM = &v
+		case "l1_2_aspm":
+			linkPM.L12ASPM = &v
+		case "clkpm":
+			linkPM.ClkPM = &v
+		}
+	}
+
+	// The uevent file may be longer than SysReadFile's 128 byte limit.
+	uevent, err := util.ReadFileNoStat(filepath.Join(path, "uevent"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read uevent %s: %w", device.Location, err)
+		}
+	} else {
+		device.Uevent, err = parsePciUevent(uevent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse uevent %s: %w", device.Location, err)
+		}
+	}
+
+	// The config file is only fully readable by root; other readers see
+	// the first 64 bytes which contain no extended capabilities.
+	config, err := util.ReadFileNoStat(filepath.Join(path, "config"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read config %s: %w", device.Location, err)
+		}
+	} else {
+		device.SerialNumber = parsePciSerialNumber(config)
+		device.ACS = parsePciACS(config)
+	}
+
+	// The iommu_group symlink only exists when an IOMMU is enabled and
+	// points to /sys/kernel/iommu_groups/<n>.
+	iommuGroup, err := os.Readlink(filepath.Join(path, "iommu_group"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to readlink iommu_group %s: %w", device.Location, err)
+		}
+	} else {
+		value, err := strconv.Atoi(filepath.Base(iommuGroup))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse iommu_group %q %s: %w", iommuGroup, device.Location, err)
+		}
+		device.IommuGroup = &value
+	}
+
+	// The physfn symlink only exists for SR-IOV virtual functions and
+	// points to the physical function the VF belongs to.
+	physFn, err := os.Readlink(filepath.Join(path, "physfn"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to readlink physfn %s: %w", device.Location, err)
+		}
+	} else {
+		device.PhysFn, err = ParsePciDeviceLocation(filepath.Base(physFn))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse physfn location %q %s: %w", physFn, device.Location, err)
+		}
+	}
+
 	return device, nil
 }
+
+// VirtFns returns the locations of the SR-IOV virtual functions of a PCI
+// physical function read from the virtfn<N> symlinks, ordered by VF index.
+// It returns nil if the device has no virtual functions enabled.
+func (pd *PciDevice) VirtFns(fs FS) ([]PciDeviceLocation, error) {
+	path := fs.sys.Path(pciDevicesPath, pd.Location.DirectoryName())
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	type virtFn struct {
+		index    int
+		location PciDeviceLocation
+	}
+	var virtFns []virtFn
+	for _, e := range entries {
+		indexStr, ok := strings.CutPrefix(e.Name(), "virtfn")
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse VF index %q %s: %w", e.Name(), pd.Location, err)
+		}
+
+		target, err := os.Readlink(filepath.Join(path, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to readlink %s %s: %w", e.Name(), pd.Location, err)
+		}
+		location, err := ParsePciDeviceLocation(filepath.Base(target))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s location %q %s: %w", e.Name(), target, pd.Location, err)
+		}
+
+		virtFns = append(virtFns, virtFn{index: index, location: *location})
+	}
+
+	// Directory entries are sorted lexically, so virtfn10 sorts before virtfn2.
+	sort.Slice(virtFns, func(i, j int) bool {
+		return virtFns[i].index < virtFns[j].index
+	})
+
+	var locations []PciDeviceLocation
+	for _, vf := range virtFns {
+		locations = append(locations, vf.location)
+	}
+
+	return locations, nil
+}
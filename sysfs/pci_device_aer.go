@@ -95,8 +95,7 @@ func parseAerCounters(deviceDir string) (*PciDeviceAerCounters, error) {
 
 // AerCounters returns AER counters for a PCI device.
 func (pci *PciDevice) AerCounters(fs FS) (*PciDeviceAerCounters, error) {
-	deviceName := fmt.Sprintf("%04x:%02x:%02x.%x", pci.Location.Segment, pci.Location.Bus, pci.Location.Device, pci.Location.Function)
-	deviceDir := fs.sys.Path(pciDevicesPath, deviceName)
+	deviceDir := fs.sys.Path(pciDevicesPath, pci.Location.DirectoryName())
 
 	pciDeviceAerCounters, err := parseAerCounters(deviceDir)
 	if err != nil {
@@ -106,119 +105,222 @@ func (pci *PciDevice) AerCounters(fs FS) (*PciDeviceAerCounters, error) {
 	return pciDeviceAerCounters, nil
 }
 
-// parseCorrectableAerCounters parses correctable error counters in
-// /sys/bus/pci/devices/<location>/aer_dev_correctable.
-func parseCorrectableAerCounters(deviceDir string, counters *CorrectableAerCounters) error {
-	path := filepath.Join(deviceDir, "aer_dev_correctable")
-	value, err := util.SysReadFile(path)
+// AllPciDeviceAerCounters is a collection of AER counters for every PCI device
+// in /sys/bus/pci/devices that supports AER.
+// The map keys are PCI locations in the same format as the PciDevices keys
+// (e.g., "0000:01:00:0"), see PciDeviceLocation.String.
+type AllPciDeviceAerCounters map[string]PciDeviceAerCounters
+
+// AllPciDeviceAerCounters returns AER counters for all PCI devices read from
+// /sys/bus/pci/devices. Devices without AER support are skipped.
+func (fs FS) AllPciDeviceAerCounters() (AllPciDeviceAerCounters, error) {
+	path := fs.sys.Path(pciDevicesPath)
+
+	dirs, err := os.ReadDir(path)
 	if err != nil {
-		return fmt.Errorf("failed to read file %q: %w", path, err)
+		return nil, err
 	}
 
-	for line := range strings.SplitSeq(string(value), "\n") {
-		if line == "" {
-			continue
+	allCounters := AllPciDeviceAerCounters{}
+	for _, d := range dirs {
+		counters, err := parseAerCounters(filepath.Join(path, d.Name()))
+		if err != nil {
+			return nil, err
 		}
-		fields := strings.Fields(line)
-		if len(fields) != 2 {
-			return fmt.Errorf("unexpected number of fields: %v", fields)
+		if counters == nil {
+			continue
 		}
-		counterName := fields[0]
-		value, err := strconv.ParseUint(fields[1], 10, 64)
+		loc, err := ParsePciDeviceLocation(d.Name())
 		if err != nil {
-			return fmt.Errorf("error parsing value for %s: %w", counterName, err)
+			return nil, err
 		}
+		allCounters[loc.String()] = *counters
+	}
 
-		switch counterName {
-		case "RxErr":
-			counters.RxErr = value
-		case "BadTLP":
-			counters.BadTLP = value
-		case "BadDLLP":
-			counters.BadDLLP = value
-		case "Rollover":
-			counters.Rollover = value
-		case "Timeout":
-			counters.Timeout = value
-		case "NonFatalErr":
-			counters.NonFatalErr = value
-		case "CorrIntErr":
-			counters.CorrIntErr = value
-		case "HeaderOF":
-			counters.HeaderOF = value
-		default:
-			continue
+	return allCounters, nil
+}
+
+// IterPciDeviceAerCounters calls fn with the location and AER counters of
+// each PCI device in /sys/bus/pci/devices that matches opts.Filter and
+// supports AER. Only the AER files of a device are read, so it is cheaper
+// than IterPciDevices for collecting AER statistics. Workers and Lenient
+// behave as for IterPciDevicesWithOptions.
+func (fs FS) IterPciDeviceAerCounters(opts PciDevicesOptions, fn func(PciDeviceLocation, PciDeviceAerCounters) error) error {
+	return fs.iterPciDevices(opts, func(name string) (func() error, error) {
+		ok, err := fs.pciDeviceMatches(name, opts.Filter)
+		if err != nil || !ok {
+			return nil, err
 		}
-	}
 
-	return nil
+		counters, err := parseAerCounters(fs.sys.Path(pciDevicesPath, name))
+		if err != nil || counters == nil {
+			return nil, err
+		}
+
+		location, err := ParsePciDeviceLocation(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error { return fn(*location, *counters) }, nil
+	})
 }
 
-// parseUncorrectableAerCounters parses uncorrectable error counters in
-// /sys/bus/pci/devices/<location>/aer_dev_[non]fatal.
-func parseUncorrectableAerCounters(deviceDir string, counterType string,
-	counters *UncorrectableAerCounters) error {
-	path := filepath.Join(deviceDir, "aer_dev_"+counterType)
-	value, err := util.ReadFileNoStat(path)
+// parseAerCounterFile parses an AER statistics file consisting of lines of
+// "<counter> <value>" pairs, as found in aer_dev_correctable,
+// aer_dev_fatal and aer_dev_nonfatal.
+func parseAerCounterFile(path string) (map[string]uint64, error) {
+	data, err := util.ReadFileNoStat(path)
 	if err != nil {
-		return fmt.Errorf("failed to read file %q: %w", path, err)
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
 	}
 
-	for line := range strings.SplitSeq(string(value), "\n") {
+	values := map[string]uint64{}
+	for line := range strings.SplitSeq(string(data), "\n") {
 		if line == "" {
 			continue
 		}
 		fields := strings.Fields(line)
 		if len(fields) != 2 {
-			return fmt.Errorf("unexpected number of fields: %v", fields)
+			return nil, fmt.Errorf("unexpected number of fields: %v", fields)
 		}
 		counterName := fields[0]
 		value, err := strconv.ParseUint(fields[1], 10, 64)
 		if err != nil {
-			return fmt.Errorf("error parsing value for %s: %w", counterName, err)
+			return nil, fmt.Errorf("error parsing value for %s: %w", counterName, err)
 		}
+		values[counterName] = value
+	}
 
-		switch counterName {
-		case "Undefined":
-			counters.Undefined = value
-		case "DLP":
-			counters.DLP = value
-		case "SDES":
-			counters.SDES = value
-		case "TLP":
-			counters.TLP = value
-		case "FCP":
-			counters.FCP = value
-		case "CmpltTO":
-			counters.CmpltTO = value
-		case "CmpltAbrt":
-			counters.CmpltAbrt = value
-		case "UnxCmplt":
-			counters.UnxCmplt = value
-		case "RxOF":
-			counters.RxOF = value
-		case "MalfTLP":
-			counters.MalfTLP = value
-		case "ECRC":
-			counters.ECRC = value
-		case "UnsupReq":
-			counters.UnsupReq = value
-		case "ACSViol":
-			counters.ACSViol = value
-		case "UncorrIntErr":
-			counters.UncorrIntErr = value
-		case "BlockedTLP":
-			counters.BlockedTLP = value
-		case "AtomicOpBlocked":
-			counters.AtomicOpBlocked = value
-		case "TLPBlockedErr":
-			counters.TLPBlockedErr = value
-		case "PoisonTLPBlocked":
-			counters.PoisonTLPBlocked = value
-		default:
-			continue
-		}
+	return values, nil
+}
+
+// parseCorrectableAerCounters parses correctable error counters in
+// /sys/bus/pci/devices/<location>/aer_dev_correctable.
+func parseCorrectableAerCounters(deviceDir string, counters *CorrectableAerCounters) error {
+	values, err := parseAerCounterFile(filepath.Join(deviceDir, "aer_dev_correctable"))
+	if err != nil {
+		return err
 	}
 
+	counters.RxErr = values["RxErr"]
+	counters.BadTLP = values["BadTLP"]
+	counters.BadDLLP = values["BadDLLP"]
+	counters.Rollover = values["Rollover"]
+	counters.Timeout = values["Timeout"]
+	counters.NonFatalErr = values["NonFatalErr"]
+	counters.CorrIntErr = values["CorrIntErr"]
+	counters.HeaderOF = values["HeaderOF"]
+
 	return nil
 }
+
+// parseUncorrectableAerCounters parses uncorrectable error counters in
+// /sys/bus/pci/devices/<location>/aer_dev_[non]fatal.
+func parseUncorrectableAerCounters(deviceDir string, counterType string,
+	counters *UncorrectableAerCounters) error {
+	values, err := parseAerCounterFile(filepath.Join(deviceDir, "aer_dev_"+counterType))
+	if err != nil {
+		return err
+	}
+
+	counters.Undefined = values["Undefined"]
+	counters.DLP = values["DLP"]
+	counters.SDES = values["SDES"]
+	counters.TLP = values["TLP"]
+	counters.FCP = values["FCP"]
+	counters.CmpltTO = values["CmpltTO"]
+	counters.CmpltAbrt = values["CmpltAbrt"]
+	counters.UnxCmplt = values["UnxCmplt"]
+	counters.RxOF = values["RxOF"]
+	counters.MalfTLP = values["MalfTLP"]
+	counters.ECRC = values["ECRC"]
+	counters.UnsupReq = values["UnsupReq"]
+	counters.ACSViol = values["ACSViol"]
+	counters.UncorrIntErr = values["UncorrIntErr"]
+	counters.BlockedTLP = values["BlockedTLP"]
+	counters.AtomicOpBlocked = values["AtomicOpBlocked"]
+	counters.TLPBlockedErr = values["TLPBlockedErr"]
+	counters.PoisonTLPBlocked = values["PoisonTLPBlocked"]
+
+	return nil
+}
+
+// Total returns the sum of all correctable error counters.
+func (c CorrectableAerCounters) Total() uint64 {
+	return c.RxErr + c.BadTLP + c.BadDLLP + c.Rollover + c.Timeout +
+		c.NonFatalErr + c.CorrIntErr + c.HeaderOF
+}
+
+// Total returns the sum of all uncorrectable error counters.
+func (c UncorrectableAerCounters) Total() uint64 {
+	return c.Undefined + c.DLP + c.SDES + c.TLP + c.FCP + c.CmpltTO +
+		c.CmpltAbrt + c.UnxCmplt + c.RxOF + c.MalfTLP + c.ECRC + c.UnsupReq +
+		c.ACSViol + c.UncorrIntErr + c.BlockedTLP + c.AtomicOpBlocked +
+		c.TLPBlockedErr + c.PoisonTLPBlocked
+}
+
+// Total returns the sum of the correctable, fatal and non-fatal counters.
+func (c PciDeviceAerCounters) Total() uint64 {
+	return c.Correctable.Total() + c.Fatal.Total() + c.NonFatal.Total()
+}
+
+// aerCounterDelta returns the increase of an AER counter from prev to cur.
+// The counters are cleared when the device is reset or re-enumerated, in
+// which case cur is smaller than prev and counts everything since the reset.
+func aerCounterDelta(cur, prev uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// Sub returns the per-counter increase from prev to c. Counters that went
+// backwards are assumed to have been reset and report their current value.
+func (c CorrectableAerCounters) Sub(prev CorrectableAerCounters) CorrectableAerCounters {
+	return CorrectableAerCounters{
+		RxErr:       aerCounterDelta(c.RxErr, prev.RxErr),
+		BadTLP:      aerCounterDelta(c.BadTLP, prev.BadTLP),
+		BadDLLP:     aerCounterDelta(c.BadDLLP, prev.BadDLLP),
+		Rollover:    aerCounterDelta(c.Rollover, prev.Rollover),
+		Timeout:     aerCounterDelta(c.Timeout, prev.Timeout),
+		NonFatalErr: aerCounterDelta(c.NonFatalErr, prev.NonFatalErr),
+		CorrIntErr:  aerCounterDelta(c.CorrIntErr, prev.CorrIntErr),
+		HeaderOF:    aerCounterDelta(c.HeaderOF, prev.HeaderOF),
+	}
+}
+
+// Sub returns the per-counter increase from prev to c. Counters that went
+// backwards are assumed to have been reset and report their current value.
+func (c UncorrectableAerCounters) Sub(prev UncorrectableAerCounters) UncorrectableAerCounters {
+	return UncorrectableAerCounters{
+		Undefined:        aerCounterDelta(c.Undefined, prev.Undefined),
+		DLP:              aerCounterDelta(c.DLP, prev.DLP),
+		SDES:             aerCounterDelta(c.SDES, prev.SDES),
+		TLP:              aerCounterDelta(c.TLP, prev.TLP),
+		FCP:              aerCounterDelta(c.FCP, prev.FCP),
+		CmpltTO:          aerCounterDelta(c.CmpltTO, prev.CmpltTO),
+		CmpltAbrt:        aerCounterDelta(c.CmpltAbrt, prev.CmpltAbrt),
+		UnxCmplt:         aerCounterDelta(c.UnxCmplt, prev.UnxCmplt),
+		RxOF:             aerCounterDelta(c.RxOF, prev.RxOF),
+		MalfTLP:          aerCounterDelta(c.MalfTLP, prev.MalfTLP),
+		ECRC:             aerCounterDelta(c.ECRC, prev.ECRC),
+		UnsupReq:         aerCounterDelta(c.UnsupReq, prev.UnsupReq),
+		ACSViol:          aerCounterDelta(c.ACSViol, prev.ACSViol),
+		UncorrIntErr:     aerCounterDelta(c.UncorrIntErr, prev.UncorrIntErr),
+		BlockedTLP:       aerCounterDelta(c.BlockedTLP, prev.BlockedTLP),
+		AtomicOpBlocked:  aerCounterDelta(c.AtomicOpBlocked, prev.AtomicOpBlocked),
+		TLPBlockedErr:    aerCounterDelta(c.TLPBlockedErr, prev.TLPBlockedErr),
+		PoisonTLPBlocked: aerCounterDelta(c.PoisonTLPBlocked, prev.PoisonTLPBlocked),
+	}
+}
+
+// Sub returns the per-counter increase from prev to c for the correctable,
+// fatal and non-fatal counters.
+func (c PciDeviceAerCounters) Sub(prev PciDeviceAerCounters) PciDeviceAerCounters {
+	return PciDeviceAerCounters{
+		Correctable: c.Correctable.Sub(prev.Correctable),
+		Fatal:       c.Fatal.Sub(prev.Fatal),
+		NonFatal:    c.NonFatal.Sub(prev.NonFatal),
+	}
+}
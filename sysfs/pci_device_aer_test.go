@@ -16,6 +16,7 @@
 package sysfs
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -170,3 +171,198 @@ func TestPciAerCounters(t *testing.T) {
 		t.Fatalf("unexpected AER counters for device 0000:a2:00:0 (-want +got):\n%s", diff)
 	}
 }
+
+func TestAllPciDeviceAerCounters(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.AllPciDeviceAerCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpointCounters := PciDeviceAerCounters{
+		Correctable: CorrectableAerCounters{
+			RxErr:       1,
+			BadTLP:      2,
+			BadDLLP:     3,
+			Rollover:    4,
+			Timeout:     5,
+			NonFatalErr: 6,
+			CorrIntErr:  7,
+			HeaderOF:    8,
+		},
+		Fatal: UncorrectableAerCounters{
+			Undefined:        9,
+			DLP:              10,
+			SDES:             11,
+			TLP:              12,
+			FCP:              13,
+			CmpltTO:          14,
+			CmpltAbrt:        15,
+			UnxCmplt:         16,
+			RxOF:             17,
+			MalfTLP:          18,
+			ECRC:             19,
+			UnsupReq:         20,
+			ACSViol:          21,
+			UncorrIntErr:     22,
+			BlockedTLP:       23,
+			AtomicOpBlocked:  24,
+			TLPBlockedErr:    25,
+			PoisonTLPBlocked: 26,
+		},
+		NonFatal: UncorrectableAerCounters{
+			Undefined:        27,
+			DLP:              28,
+			SDES:             29,
+			TLP:              30,
+			FCP:              31,
+			CmpltTO:          32,
+			CmpltAbrt:        33,
+			UnxCmplt:         34,
+			RxOF:             35,
+			MalfTLP:          36,
+			ECRC:             37,
+			UnsupReq:         38,
+			ACSViol:          39,
+			UncorrIntErr:     40,
+			BlockedTLP:       41,
+			AtomicOpBlocked:  42,
+			TLPBlockedErr:    43,
+			PoisonTLPBlocked: 44,
+		},
+	}
+
+	// The VFs 0000:a2:01.0 and 0000:a2:01.1 have no AER support and are skipped.
+	want := AllPciDeviceAerCounters{
+		"0000:00:02:1": PciDeviceAerCounters{},
+		"0000:01:00:0": endpointCounters,
+		"0000:a2:00:0": endpointCounters,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected AER counters (-want +got):\n%s", diff)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key := range got {
+		if _, ok := devices[key]; !ok {
+			t.Errorf("AER counters key %q has no matching PCI device", key)
+		}
+	}
+}
+
+func TestIterPciDeviceAerCounters(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := fs.AllPciDeviceAerCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opts PciDevicesOptions
+		want []string
+	}{
+		{
+			name: "all devices",
+			want: []string{"0000:00:02:1", "0000:01:00:0", "0000:a2:00:0"},
+		},
+		{
+			name: "concurrent",
+			opts: PciDevicesOptions{Workers: 4},
+			want: []string{"0000:00:02:1", "0000:01:00:0", "0000:a2:00:0"},
+		},
+		{
+			name: "filtered by driver",
+			opts: PciDevicesOptions{Filter: PciFilter{Driver: "ice"}},
+			want: []string{"0000:a2:00:0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AllPciDeviceAerCounters{}
+			err := fs.IterPciDeviceAerCounters(tt.opts, func(location PciDeviceLocation, counters PciDeviceAerCounters) error {
+				got[location.String()] = counters
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := AllPciDeviceAerCounters{}
+			for _, key := range tt.want {
+				want[key] = all[key]
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("unexpected AER counters (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// An error returned by the callback stops the iteration.
+	errStop := errors.New("stop")
+	var count int
+	err = fs.IterPciDeviceAerCounters(PciDevicesOptions{}, func(PciDeviceLocation, PciDeviceAerCounters) error {
+		count++
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected callback error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected iteration to stop after 1 device, got %d", count)
+	}
+}
+
+func TestPciDeviceAerCountersSub(t *testing.T) {
+	prev := PciDeviceAerCounters{
+		Correctable: CorrectableAerCounters{RxErr: 10, BadTLP: 5, HeaderOF: 7},
+		Fatal:       UncorrectableAerCounters{DLP: 1},
+		NonFatal:    UncorrectableAerCounters{UnsupReq: 100, ECRC: 2},
+	}
+	cur := PciDeviceAerCounters{
+		Correctable: CorrectableAerCounters{RxErr: 15, BadTLP: 5, HeaderOF: 7, Timeout: 3},
+		Fatal:       UncorrectableAerCounters{DLP: 1},
+		// UnsupReq went backwards, so the counters were reset in between.
+		NonFatal: UncorrectableAerCounters{UnsupReq: 4, ECRC: 2},
+	}
+
+	want := PciDeviceAerCounters{
+		Correctable: CorrectableAerCounters{RxErr: 5, Timeout: 3},
+		NonFatal:    UncorrectableAerCounters{UnsupReq: 4},
+	}
+
+	if diff := cmp.Diff(want, cur.Sub(prev)); diff != "" {
+		t.Fatalf("unexpected AER counter delta (-want +got):\n%s", diff)
+	}
+}
+
+func TestPciDeviceAerCountersTotal(t *testing.T) {
+	counters := PciDeviceAerCounters{
+		Correctable: CorrectableAerCounters{RxErr: 1, BadTLP: 2, HeaderOF: 8},
+		Fatal:       UncorrectableAerCounters{DLP: 20, PoisonTLPBlocked: 44},
+		NonFatal:    UncorrectableAerCounters{Undefined: 25, UnsupReq: 38},
+	}
+
+	if got, want := counters.Correctable.Total(), uint64(11); got != want {
+		t.Errorf("unexpected correctable total: want %d, got %d", want, got)
+	}
+	if got, want := counters.Fatal.Total(), uint64(64); got != want {
+		t.Errorf("unexpected fatal total: want %d, got %d", want, got)
+	}
+	if got, want := counters.Total(), uint64(138); got != want {
+		t.Errorf("unexpected total: want %d, got %d", want, got)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	pciClassBridgePci         = 0x0604 // PCI-to-PCI bridge
+	pciClassBridgePciSemiTran = 0x0609 // Semi-transparent PCI-to-PCI bridge
+
+	// The resource file of a bridge ends with the four bridge windows
+	// (I/O, memory, prefetchable memory and a CardBus-only window), see
+	// PCI_BRIDGE_RESOURCES in the kernel.
+	pciBridgeResourceNum = 4
+)
+
+// PciBridgeWindow is an address range forwarded by a PCI bridge to its
+// secondary bus, as listed in /sys/bus/pci/devices/<Location>/resource .
+type PciBridgeWindow struct {
+	Start uint64
+	End   uint64
+	Flags uint64 // IORESOURCE_* flags from include/linux/ioport.h
+}
+
+// PciBridgeInfo contains info about a PCI-to-PCI bridge from files in
+// /sys/bus/pci/devices/<Location>/ .
+type PciBridgeInfo struct {
+	SecondaryBus   *uint32 // /sys/bus/pci/devices/<Location>/secondary_bus_number
+	SubordinateBus *uint32 // /sys/bus/pci/devices/<Location>/subordinate_bus_number
+
+	IOWindow           *PciBridgeWindow // /sys/bus/pci/devices/<Location>/resource
+	MemoryWindow       *PciBridgeWindow // /sys/bus/pci/devices/<Location>/resource
+	PrefetchableWindow *PciBridgeWindow // /sys/bus/pci/devices/<Location>/resource
+}
+
+// isPciBridge reports whether the 24-bit class code is a PCI-to-PCI bridge.
+func isPciBridge(class uint32) bool {
+	switch class >> 8 {
+	case pciClassBridgePci, pciClassBridgePciSemiTran:
+		return true
+	}
+	return false
+}
+
+// parsePciBridgeInfo parses the bus numbers and windows of the bridge in
+// the given device directory.
+func parsePciBridgeInfo(path string) (*PciBridgeInfo, error) {
+	bridge := &PciBridgeInfo{}
+
+	for _, f := range [...]string{"secondary_bus_number", "subordinate_bus_number"} {
+		name := filepath.Join(path, f)
+		valueStr, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		value, err := strconv.ParseUint(valueStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s %q: %w", f, valueStr, err)
+		}
+		v := uint32(value)
+
+		switch f {
+		case "secondary_bus_number":
+			bridge.SecondaryBus = &v
+		case "subordinate_bus_number":
+			bridge.SubordinateBus = &v
+		}
+	}
+
+	name := filepath.Join(path, "resource")
+	data, err := util.ReadFileNoStat(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bridge, nil
+		}
+		return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) < pciBridgeResourceNum {
+		return nil, fmt.Errorf("unexpected number of resources in %q: %d", name, len(lines))
+	}
+
+	windows := lines[len(lines)-pciBridgeResourceNum:]
+	for i, window := range []**PciBridgeWindow{&bridge.IOWindow, &bridge.MemoryWindow, &bridge.PrefetchableWindow} {
+		w, err := parsePciResource(windows[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bridge window in %q: %w", name, err)
+		}
+		*window = w
+	}
+
+	return bridge, nil
+}
+
+// parsePciResource parses a "start end flags" line of a resource file.
+// It returns nil for unassigned resources.
+func parsePciResource(line string) (*PciBridgeWindow, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected number of fields: %v", fields)
+	}
+
+	values := make([]uint64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseUint(f, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource value %q: %w", f, err)
+		}
+		values[i] = v
+	}
+
+	if values[0] == 0 && values[1] == 0 && values[2] == 0 {
+		return nil, nil
+	}
+
+	return &PciBridgeWindow{
+		Start: values[0],
+		End:   values[1],
+		Flags: values[2],
+	}, nil
+}
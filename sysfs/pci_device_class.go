@@ -0,0 +1,104 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+const (
+	pciBaseClassStorage = 0x01
+	pciBaseClassNetwork = 0x02
+	pciBaseClassDisplay = 0x03
+
+	pciClassStorageNVMe = 0x0108 // Non-Volatile memory controller
+	pciProgIfNVMe       = 0x02   // NVM Express
+)
+
+// pciBaseClassNames are the PCI base class names as listed in pci.ids.
+var pciBaseClassNames = map[uint8]string{
+	0x00: "Unclassified device",
+	0x01: "Mass storage controller",
+	0x02: "Network controller",
+	0x03: "Display controller",
+	0x04: "Multimedia controller",
+	0x05: "Memory controller",
+	0x06: "Bridge",
+	0x07: "Communication controller",
+	0x08: "Generic system peripheral",
+	0x09: "Input device controller",
+	0x0a: "Docking station",
+	0x0b: "Processor",
+	0x0c: "Serial bus controller",
+	0x0d: "Wireless controller",
+	0x0e: "Intelligent controller",
+	0x0f: "Satellite communications controller",
+	0x10: "Encryption controller",
+	0x11: "Signal processing controller",
+	0x12: "Processing accelerators",
+	0x13: "Non-Essential Instrumentation",
+	0x40: "Coprocessor",
+	0xff: "Unassigned class",
+}
+
+// BaseClass returns the base class, the upper byte of the 24-bit class code.
+func (pd PciDevice) BaseClass() uint8 {
+	return uint8(pd.Class >> 16)
+}
+
+// SubClass returns the sub-class, the middle byte of the 24-bit class code.
+func (pd PciDevice) SubClass() uint8 {
+	return uint8(pd.Class >> 8)
+}
+
+// ProgIf returns the programming interface, the lower byte of the 24-bit
+// class code.
+func (pd PciDevice) ProgIf() uint8 {
+	return uint8(pd.Class)
+}
+
+// ClassName returns the name of the base class of the device, like
+// "Network controller", or "Unknown" for reserved base classes.
+func (pd PciDevice) ClassName() string {
+	if name, ok := pciBaseClassNames[pd.BaseClass()]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// IsNetworkController reports whether the device is a network controller.
+func (pd PciDevice) IsNetworkController() bool {
+	return pd.BaseClass() == pciBaseClassNetwork
+}
+
+// IsGPU reports whether the device is a display controller, which includes
+// VGA compatible, XGA, 3D and other display controllers.
+func (pd PciDevice) IsGPU() bool {
+	return pd.BaseClass() == pciBaseClassDisplay
+}
+
+// IsNVMe reports whether the device is an NVM Express controller.
+func (pd PciDevice) IsNVMe() bool {
+	return pd.Class>>8 == pciClassStorageNVMe && pd.ProgIf() == pciProgIfNVMe
+}
+
+// IsStorageController reports whether the device is a mass storage
+// controller, like a SATA, SAS or NVMe controller.
+func (pd PciDevice) IsStorageController() bool {
+	return pd.BaseClass() == pciBaseClassStorage
+}
+
+// IsBridge reports whether the device is a PCI-to-PCI bridge, like a root
+// port or a switch port.
+func (pd PciDevice) IsBridge() bool {
+	return isPciBridge(pd.Class)
+}
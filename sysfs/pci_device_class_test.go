@@ -0,0 +1,69 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+)
+
+func TestPciDeviceClass(t *testing.T) {
+	tests := []struct {
+		class     uint32
+		name      string
+		network   bool
+		gpu       bool
+		nvme      bool
+		storage   bool
+		bridge    bool
+		baseClass uint8
+		subClass  uint8
+		progIf    uint8
+	}{
+		{class: 0x010802, name: "Mass storage controller", nvme: true, storage: true, baseClass: 0x01, subClass: 0x08, progIf: 0x02},
+		{class: 0x010601, name: "Mass storage controller", storage: true, baseClass: 0x01, subClass: 0x06, progIf: 0x01},
+		{class: 0x020000, name: "Network controller", network: true, baseClass: 0x02},
+		{class: 0x030000, name: "Display controller", gpu: true, baseClass: 0x03},
+		{class: 0x030200, name: "Display controller", gpu: true, baseClass: 0x03, subClass: 0x02},
+		{class: 0x060400, name: "Bridge", bridge: true, baseClass: 0x06, subClass: 0x04},
+		{class: 0x060000, name: "Bridge", baseClass: 0x06},
+		{class: 0x200000, name: "Unknown", baseClass: 0x20},
+	}
+
+	for _, tt := range tests {
+		pd := PciDevice{Class: tt.class}
+		if got := pd.ClassName(); got != tt.name {
+			t.Errorf("class %06x: unexpected name %q, want %q", tt.class, got, tt.name)
+		}
+		if got := pd.IsNetworkController(); got != tt.network {
+			t.Errorf("class %06x: unexpected IsNetworkController %t", tt.class, got)
+		}
+		if got := pd.IsGPU(); got != tt.gpu {
+			t.Errorf("class %06x: unexpected IsGPU %t", tt.class, got)
+		}
+		if got := pd.IsNVMe(); got != tt.nvme {
+			t.Errorf("class %06x: unexpected IsNVMe %t", tt.class, got)
+		}
+		if got := pd.IsStorageController(); got != tt.storage {
+			t.Errorf("class %06x: unexpected IsStorageController %t", tt.class, got)
+		}
+		if got := pd.IsBridge(); got != tt.bridge {
+			t.Errorf("class %06x: unexpected IsBridge %t", tt.class, got)
+		}
+		if pd.BaseClass() != tt.baseClass || pd.SubClass() != tt.subClass || pd.ProgIf() != tt.progIf {
+			t.Errorf("class %06x: unexpected decoding %02x %02x %02x", tt.class, pd.BaseClass(), pd.SubClass(), pd.ProgIf())
+		}
+	}
+}
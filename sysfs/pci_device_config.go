@@ -0,0 +1,128 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// PCIe extended capabilities start right after the 256 byte
+	// conventional PCI configuration space.
+	pciExtCapOffset = 0x100
+	// pciExtCapMaxCount bounds the capability walk so a malformed chain
+	// cannot loop forever, see PCI_CFG_SPACE_EXP_SIZE in the kernel.
+	pciExtCapMaxCount = (4096 - pciExtCapOffset) / 8
+
+	pciExtCapIDDSN = 0x0003 // Device Serial Number
+	pciExtCapIDACS = 0x000d // Access Control Services
+)
+
+// ACS capability and control register bits, see PCI_ACS_* in
+// include/uapi/linux/pci_regs.h .
+const (
+	pciACSSV = 1 << iota // Source Validation
+	pciACSTB             // Translation Blocking
+	pciACSRR             // P2P Request Redirect
+	pciACSCR             // P2P Completion Redirect
+	pciACSUF             // Upstream Forwarding
+	pciACSEC             // P2P Egress Control
+	pciACSDT             // Direct Translated P2P
+)
+
+// PciACSControls is a set of PCIe Access Control Services controls.
+type PciACSControls struct {
+	SourceValidation    bool // SV
+	TranslationBlocking bool // TB
+	RequestRedirect     bool // RR
+	CompletionRedirect  bool // CR
+	UpstreamForwarding  bool // UF
+	EgressControl       bool // EC
+	DirectTranslatedP2P bool // DT
+}
+
+// PciACS contains the Access Control Services extended capability of a PCIe
+// port, decoded from /sys/bus/pci/devices/<Location>/config .
+type PciACS struct {
+	Capability PciACSControls // controls supported by the port
+	Control    PciACSControls // controls currently enabled
+}
+
+// findPciExtCapability returns the offset of the PCIe extended capability
+// with the given ID in the config space, or 0 if it is not present.
+//
+// Unprivileged readers only see the first 64 bytes of the config file, in
+// which case no extended capability is ever found.
+func findPciExtCapability(config []byte, id uint16) int {
+	offset := pciExtCapOffset
+	for range pciExtCapMaxCount {
+		if offset < pciExtCapOffset || offset+4 > len(config) {
+			return 0
+		}
+
+		header := binary.LittleEndian.Uint32(config[offset:])
+		if header == 0 || header == 0xffffffff {
+			return 0
+		}
+		if uint16(header) == id {
+			return offset
+		}
+
+		// Bits 31:20 hold the offset of the next capability.
+		offset = int(header>>20) &^ 3
+	}
+
+	return 0
+}
+
+// parsePciSerialNumber extracts the 64 bit serial number from the Device
+// Serial Number extended capability. It returns nil if the capability is not
+// present.
+func parsePciSerialNumber(config []byte) *uint64 {
+	offset := findPciExtCapability(config, pciExtCapIDDSN)
+	if offset == 0 || offset+12 > len(config) {
+		return nil
+	}
+
+	serial := binary.LittleEndian.Uint64(config[offset+4:])
+	return &serial
+}
+
+// parsePciACS decodes the Access Control Services extended capability.
+// It returns nil if the capability is not present.
+func parsePciACS(config []byte) *PciACS {
+	offset := findPciExtCapability(config, pciExtCapIDACS)
+	if offset == 0 || offset+8 > len(config) {
+		return nil
+	}
+
+	return &PciACS{
+		Capability: newPciACSControls(binary.LittleEndian.Uint16(config[offset+4:])),
+		Control:    newPciACSControls(binary.LittleEndian.Uint16(config[offset+6:])),
+	}
+}
+
+func newPciACSControls(reg uint16) PciACSControls {
+	return PciACSControls{
+		SourceValidation:    reg&pciACSSV != 0,
+		TranslationBlocking: reg&pciACSTB != 0,
+		RequestRedirect:     reg&pciACSRR != 0,
+		CompletionRedirect:  reg&pciACSCR != 0,
+		UpstreamForwarding:  reg&pciACSUF != 0,
+		EgressControl:       reg&pciACSEC != 0,
+		DirectTranslatedP2P: reg&pciACSDT != 0,
+	}
+}
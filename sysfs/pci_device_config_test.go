@@ -0,0 +1,54 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestFindPciExtCapability(t *testing.T) {
+	config := make([]byte, 0x200)
+	// AER at 0x100 pointing to DSN at 0x140.
+	binary.LittleEndian.PutUint32(config[0x100:], 0x0001|2<<16|0x140<<20)
+	binary.LittleEndian.PutUint32(config[0x140:], 0x0003|1<<16)
+	binary.LittleEndian.PutUint64(config[0x144:], 0x0123456789abcdef)
+
+	if got := findPciExtCapability(config, pciExtCapIDDSN); got != 0x140 {
+		t.Errorf("unexpected DSN offset: want 0x140, got %#x", got)
+	}
+	if got := findPciExtCapability(config, pciExtCapIDACS); got != 0 {
+		t.Errorf("unexpected ACS offset: want 0, got %#x", got)
+	}
+	if got := parsePciACS(config); got != nil {
+		t.Errorf("expected no ACS capability, got %+v", got)
+	}
+	if got := parsePciSerialNumber(config); got == nil || *got != 0x0123456789abcdef {
+		t.Errorf("unexpected serial number: %v", got)
+	}
+
+	// Only the first 64 bytes are readable without privileges.
+	if got := parsePciSerialNumber(config[:64]); got != nil {
+		t.Errorf("expected no serial number in truncated config, got %#x", *got)
+	}
+
+	// A capability pointing to itself must not loop forever.
+	loop := make([]byte, 0x200)
+	binary.LittleEndian.PutUint32(loop[0x100:], 0x0001|1<<16|0x100<<20)
+	if got := findPciExtCapability(loop, pciExtCapIDDSN); got != 0 {
+		t.Errorf("unexpected DSN offset in looping chain: %#x", got)
+	}
+}
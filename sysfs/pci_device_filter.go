@@ -0,0 +1,118 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// PciFilter selects the PCI devices returned by PciDevicesWithFilter.
+// Every non-zero field must match for a device to be selected; the zero
+// value matches all devices.
+type PciFilter struct {
+	// ClassPrefix matches the leading hex digits of the 24-bit class code,
+	// e.g. "02" for network controllers or "0108" for NVMe controllers.
+	ClassPrefix string
+	// Vendor matches the vendor ID, e.g. 0x8086.
+	Vendor *uint32
+	// Driver matches the name of the bound driver, e.g. "nvme". Devices
+	// without a bound driver never match.
+	Driver string
+	// NumaNode matches the NUMA node, e.g. -1 for devices without affinity
+	// or without a numa_node file.
+	NumaNode *int32
+}
+
+// pciDeviceMatches reports whether the device with the given name matches
+// the filter. Only the attributes needed by the filter are read.
+func (fs FS) pciDeviceMatches(name string, filter PciFilter) (bool, error) {
+	path := fs.sys.Path(pciDevicesPath, name)
+
+	if filter.ClassPrefix != "" {
+		value, err := readPciDeviceHex(path, "class")
+		if err != nil {
+			return false, err
+		}
+		if !strings.HasPrefix(fmt.Sprintf("%06x", value), strings.ToLower(filter.ClassPrefix)) {
+			return false, nil
+		}
+	}
+
+	if filter.Vendor != nil {
+		value, err := readPciDeviceHex(path, "vendor")
+		if err != nil {
+			return false, err
+		}
+		if value != *filter.Vendor {
+			return false, nil
+		}
+	}
+
+	if filter.Driver != "" {
+		driver, err := os.Readlink(filepath.Join(path, "driver"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to readlink driver %s: %w", name, err)
+		}
+		if filepath.Base(driver) != filter.Driver {
+			return false, nil
+		}
+	}
+
+	if filter.NumaNode != nil {
+		// Kernels without NUMA support have no numa_node file; such
+		// devices have no affinity, like those reporting -1.
+		value := int64(-1)
+		file := filepath.Join(path, "numa_node")
+		valueStr, err := util.SysReadFile(file)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return false, fmt.Errorf("failed to read file %q: %w", file, err)
+			}
+		} else {
+			value, err = strconv.ParseInt(valueStr, 10, 32)
+			if err != nil {
+				return false, fmt.Errorf("failed to parse numa_node %q %s: %w", valueStr, name, err)
+			}
+		}
+		if int32(value) != *filter.NumaNode {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func readPciDeviceHex(path, f string) (uint32, error) {
+	name := filepath.Join(path, f)
+	valueStr, err := util.SysReadFile(name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file %q: %w", name, err)
+	}
+	value, err := strconv.ParseInt(valueStr, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s %q: %w", f, valueStr, err)
+	}
+	return uint32(value), nil
+}
@@ -0,0 +1,135 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPciDevicesWithFilter(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		vendorAMD   = uint32(0x1022)
+		vendorIntel = uint32(0x8086)
+		numaNode1   = int32(1)
+		numaNodeNeg = int32(-1)
+	)
+
+	tests := []struct {
+		name   string
+		filter PciFilter
+		want   []string
+	}{
+		{
+			name:   "no filter",
+			filter: PciFilter{},
+			want:   []string{"0000:00:02:1", "0000:01:00:0", "0000:a2:00:0", "0000:a2:01:0", "0000:a2:01:1"},
+		},
+		{
+			name:   "network controllers",
+			filter: PciFilter{ClassPrefix: "02"},
+			want:   []string{"0000:a2:00:0", "0000:a2:01:0", "0000:a2:01:1"},
+		},
+		{
+			name:   "nvme class",
+			filter: PciFilter{ClassPrefix: "0108"},
+			want:   []string{"0000:01:00:0"},
+		},
+		{
+			name:   "vendor",
+			filter: PciFilter{Vendor: &vendorAMD},
+			want:   []string{"0000:00:02:1"},
+		},
+		{
+			name:   "driver",
+			filter: PciFilter{Driver: "nvme"},
+			want:   []string{"0000:01:00:0"},
+		},
+		{
+			name:   "numa node",
+			filter: PciFilter{NumaNode: &numaNodeNeg},
+			want:   []string{"0000:00:02:1", "0000:01:00:0"},
+		},
+		{
+			name:   "combined",
+			filter: PciFilter{Vendor: &vendorIntel, Driver: "ice", NumaNode: &numaNode1},
+			want:   []string{"0000:a2:00:0"},
+		},
+		{
+			name:   "no match",
+			filter: PciFilter{ClassPrefix: "03"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices, err := fs.PciDevicesWithFilter(tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for name := range devices {
+				got = append(got, name)
+			}
+			slices.Sort(got)
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("unexpected devices (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPciDeviceMatchesWithoutNumaNode(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Kernels built without NUMA support have no numa_node file.
+	if err := os.MkdirAll(filepath.Join(tempDir, pciDevicesPath, "0000:00:1f.0"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		node int32
+		want bool
+	}{
+		{node: -1, want: true},
+		{node: 0, want: false},
+	} {
+		got, err := fs.pciDeviceMatches("0000:00:1f.0", PciFilter{NumaNode: &tt.node})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("NUMA node %d: want match %t, got %t", tt.node, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+const pciRescanPath = "bus/pci/rescan"
+
+// Remove hot-unplugs the PCI device from the kernel by writing to
+// /sys/bus/pci/devices/<Location>/remove . The device reappears after a
+// rescan of its parent bus. The FS must have been created with
+// NewWritableFS.
+func (pci *PciDevice) Remove(fs FS) error {
+	return fs.writeFile(fs.sys.Path(pciDevicesPath, pci.Location.DirectoryName(), "remove"), "1")
+}
+
+// Rescan rescans the PCI device by writing to
+// /sys/bus/pci/devices/<Location>/rescan . For a bridge this discovers
+// devices on its secondary buses. The FS must have been created with
+// NewWritableFS.
+func (pci *PciDevice) Rescan(fs FS) error {
+	return fs.writeFile(fs.sys.Path(pciDevicesPath, pci.Location.DirectoryName(), "rescan"), "1")
+}
+
+// PciRescan rescans all PCI buses in the system by writing to
+// /sys/bus/pci/rescan . The FS must have been created with NewWritableFS.
+func (fs FS) PciRescan() error {
+	return fs.writeFile(fs.sys.Path(pciRescanPath), "1")
+}
@@ -0,0 +1,83 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPciDeviceRemoveRescan(t *testing.T) {
+	tempDir := t.TempDir()
+
+	writeMockPciDevice(t, tempDir, "0000:00:1c.0", map[string]string{
+		"class":  "0x060400",
+		"remove": "",
+		"rescan": "",
+	})
+	rescanPath := filepath.Join(tempDir, pciRescanPath)
+	if err := os.WriteFile(rescanPath, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	roFS, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices, err := roFS.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridge := devices["0000:00:1c:0"]
+
+	if err := bridge.Remove(roFS); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("expected ErrReadOnlyFS removing device, got %v", err)
+	}
+	if err := roFS.PciRescan(); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("expected ErrReadOnlyFS rescanning, got %v", err)
+	}
+
+	fs, err := NewWritableFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bridge.Remove(fs); err != nil {
+		t.Fatal(err)
+	}
+	if err := bridge.Rescan(fs); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.PciRescan(); err != nil {
+		t.Fatal(err)
+	}
+
+	devicePath := filepath.Join(tempDir, pciDevicesPath, "0000:00:1c.0")
+	for _, path := range []string{
+		filepath.Join(devicePath, "remove"),
+		filepath.Join(devicePath, "rescan"),
+		rescanPath,
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "1" {
+			t.Errorf("unexpected content of %q: %q", path, got)
+		}
+	}
+}
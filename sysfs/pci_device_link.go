@@ -0,0 +1,79 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+// PciLinkStatus compares the negotiated PCIe link of a device to the
+// maximum link it supports.
+type PciLinkStatus struct {
+	CurrentSpeed float64 // GT/s
+	MaxSpeed     float64 // GT/s
+	CurrentWidth float64 // lanes
+	MaxWidth     float64 // lanes
+
+	// Bandwidth per direction in GB/s after line encoding overhead.
+	CurrentBandwidth float64
+	MaxBandwidth     float64
+
+	SpeedDegraded bool // link trained below its maximum speed
+	WidthDegraded bool // link trained with fewer than its maximum lanes
+}
+
+// LinkStatus returns the link status of a PCIe device. It returns nil if
+// the device does not report both its current and maximum link speed and
+// width.
+func (pd PciDevice) LinkStatus() *PciLinkStatus {
+	if pd.CurrentLinkSpeed == nil || pd.MaxLinkSpeed == nil ||
+		pd.CurrentLinkWidth == nil || pd.MaxLinkWidth == nil {
+		return nil
+	}
+
+	return &PciLinkStatus{
+		CurrentSpeed:     *pd.CurrentLinkSpeed,
+		MaxSpeed:         *pd.MaxLinkSpeed,
+		CurrentWidth:     *pd.CurrentLinkWidth,
+		MaxWidth:         *pd.MaxLinkWidth,
+		CurrentBandwidth: PciLinkBandwidth(*pd.CurrentLinkSpeed, *pd.CurrentLinkWidth),
+		MaxBandwidth:     PciLinkBandwidth(*pd.MaxLinkSpeed, *pd.MaxLinkWidth),
+		SpeedDegraded:    *pd.CurrentLinkSpeed < *pd.MaxLinkSpeed,
+		WidthDegraded:    *pd.CurrentLinkWidth < *pd.MaxLinkWidth,
+	}
+}
+
+// LinkDegraded reports whether the PCIe link trained below its maximum
+// speed or width. It returns false if the link status is unknown.
+func (pd PciDevice) LinkDegraded() bool {
+	status := pd.LinkStatus()
+	return status != nil && (status.SpeedDegraded || status.WidthDegraded)
+}
+
+// PciLinkBandwidth returns the usable bandwidth per direction in GB/s of a
+// PCIe link with the given speed in GT/s and width in lanes.
+func PciLinkBandwidth(speed, width float64) float64 {
+	var efficiency float64
+	switch {
+	case speed <= 5.0:
+		// PCIe 1.x and 2.x use 8b/10b encoding.
+		efficiency = 8.0 / 10.0
+	case speed <= 32.0:
+		// PCIe 3.x to 5.x use 128b/130b encoding.
+		efficiency = 128.0 / 130.0
+	default:
+		// PCIe 6.x and later use 242B/256B FLIT mode.
+		efficiency = 242.0 / 256.0
+	}
+
+	return speed * efficiency * width / 8
+}
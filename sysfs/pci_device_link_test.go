@@ -0,0 +1,108 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPciLinkStatus(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		want     *PciLinkStatus
+		degraded bool
+	}{
+		{
+			name: "0000:00:02:1",
+			want: &PciLinkStatus{
+				CurrentSpeed:     8,
+				MaxSpeed:         8,
+				CurrentWidth:     4,
+				MaxWidth:         8,
+				CurrentBandwidth: 3.938,
+				MaxBandwidth:     7.877,
+				WidthDegraded:    true,
+			},
+			degraded: true,
+		},
+		{
+			name: "0000:a2:00:0",
+			want: &PciLinkStatus{
+				CurrentSpeed:     16,
+				MaxSpeed:         16,
+				CurrentWidth:     8,
+				MaxWidth:         8,
+				CurrentBandwidth: 15.754,
+				MaxBandwidth:     15.754,
+			},
+		},
+		{
+			name: "0000:a2:01:0",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device, ok := devices[tt.name]
+			if !ok {
+				t.Fatalf("device %s not found", tt.name)
+			}
+
+			got := device.LinkStatus()
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateApprox(0, 0.001)); diff != "" {
+				t.Fatalf("unexpected link status (-want +got):\n%s", diff)
+			}
+
+			if device.LinkDegraded() != tt.degraded {
+				t.Fatalf("unexpected link degraded: want %t", tt.degraded)
+			}
+		})
+	}
+}
+
+func TestPciLinkBandwidth(t *testing.T) {
+	tests := []struct {
+		speed, width, want float64
+	}{
+		{speed: 2.5, width: 1, want: 0.25},
+		{speed: 5.0, width: 16, want: 8.0},
+		{speed: 8.0, width: 16, want: 15.754},
+		{speed: 32.0, width: 16, want: 63.015},
+		{speed: 64.0, width: 16, want: 121.0},
+	}
+
+	for _, tt := range tests {
+		got := PciLinkBandwidth(tt.speed, tt.width)
+		if math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("unexpected bandwidth for %.1f GT/s x%.0f: want %.3f, got %.3f", tt.speed, tt.width, tt.want, got)
+		}
+	}
+}
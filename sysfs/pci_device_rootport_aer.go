@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -27,12 +28,20 @@ import (
 
 const pcieportDriverPath = "bus/pci/drivers/pcieport"
 
+// RootPortAerCounters contains the totals of the errors reported to a root
+// port from /sys/bus/pci/drivers/pcieport/<device>/aer_rootport_total_err_* .
 type RootPortAerCounters struct {
 	TotalErrCor      uint64
 	TotalErrFatal    uint64
 	TotalErrNonFatal uint64
 }
 
+// Total returns the sum of the correctable, fatal and non-fatal errors
+// reported to the root port.
+func (c RootPortAerCounters) Total() uint64 {
+	return c.TotalErrCor + c.TotalErrFatal + c.TotalErrNonFatal
+}
+
 // AllRootPortAerCounters is collection of root port AER counters for every root port device
 // in /sys/bus/pci/drivers/pcieport.
 // The map keys are device names (e.g., "0000:00:02.1").
@@ -83,6 +92,82 @@ func (fs FS) RootPortAerCounters() (AllRootPortAerCounters, error) {
 	return allRootPortAerCounters, nil
 }
 
+// RootPortEndpoint is an endpoint device below a PCIe root port.
+type RootPortEndpoint struct {
+	Location PciDeviceLocation
+	Driver   string // bound driver, empty if none
+}
+
+// RootPortAerCountersWithEndpoints contains the AER counters of a root port
+// together with the endpoint devices behind it, which are the link partners
+// affected by the errors counted at the root port.
+type RootPortAerCountersWithEndpoints struct {
+	RootPortAerCounters
+	Endpoints []RootPortEndpoint
+}
+
+// AllRootPortAerCountersWithEndpoints is a collection of root port AER
+// counters with their endpoints for every root port device in
+// /sys/bus/pci/drivers/pcieport.
+// The map keys are device names (e.g., "0000:00:02.1").
+type AllRootPortAerCountersWithEndpoints map[string]RootPortAerCountersWithEndpoints
+
+// RootPortAerCountersWithEndpoints returns root port AER counters like
+// RootPortAerCounters, and resolves the endpoint devices below each root port
+// from the PCI device topology in /sys/bus/pci/devices. Endpoints are
+// sorted by location; bridges and switch ports in between are omitted.
+func (fs FS) RootPortAerCountersWithEndpoints() (AllRootPortAerCountersWithEndpoints, error) {
+	rootPorts, err := fs.RootPortAerCounters()
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(map[string]*PciDeviceLocation, len(devices))
+	for _, device := range devices {
+		parents[device.Location.DirectoryName()] = device.ParentLocation
+	}
+
+	all := make(AllRootPortAerCountersWithEndpoints, len(rootPorts))
+	for name, counters := range rootPorts {
+		all[name] = RootPortAerCountersWithEndpoints{RootPortAerCounters: counters}
+	}
+
+	for _, device := range devices {
+		if device.Bridge != nil {
+			continue
+		}
+
+		// Walk up the topology to the first ancestor that is a root port.
+		for parent := device.ParentLocation; parent != nil; parent = parents[parent.DirectoryName()] {
+			rootPort, ok := all[parent.DirectoryName()]
+			if !ok {
+				continue
+			}
+
+			endpoint := RootPortEndpoint{Location: device.Location}
+			if device.Uevent != nil {
+				endpoint.Driver = device.Uevent.Driver
+			}
+			rootPort.Endpoints = append(rootPort.Endpoints, endpoint)
+			all[parent.DirectoryName()] = rootPort
+			break
+		}
+	}
+
+	for _, rootPort := range all {
+		slices.SortFunc(rootPort.Endpoints, func(a, b RootPortEndpoint) int {
+			return strings.Compare(a.Location.DirectoryName(), b.Location.DirectoryName())
+		})
+	}
+
+	return all, nil
+}
+
 // parseRootPortAerCounters parses root port AER error counters from
 // /sys/bus/pci/drivers/pcieport/<device>/aer_rootport_total_err_* files.
 // Returns nil if AER is not supported for the device.
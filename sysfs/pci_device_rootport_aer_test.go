@@ -49,3 +49,42 @@ func TestRootPortAerCounters(t *testing.T) {
 		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
 	}
 }
+
+func TestRootPortAerCountersWithEndpoints(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.RootPortAerCountersWithEndpoints()
+	if err != nil {
+		t.Fatalf("failed to get root port AER counters: %v", err)
+	}
+
+	want := AllRootPortAerCountersWithEndpoints{
+		"0000:00:02.1": RootPortAerCountersWithEndpoints{
+			RootPortAerCounters: RootPortAerCounters{
+				TotalErrCor:      1,
+				TotalErrFatal:    2,
+				TotalErrNonFatal: 3,
+			},
+			Endpoints: []RootPortEndpoint{
+				{
+					Location: PciDeviceLocation{Segment: 0, Bus: 1, Device: 0, Function: 0},
+					Driver:   "nvme",
+				},
+			},
+		},
+		"0000:00:04.1": RootPortAerCountersWithEndpoints{
+			RootPortAerCounters: RootPortAerCounters{
+				TotalErrCor:      4,
+				TotalErrFatal:    5,
+				TotalErrNonFatal: 6,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
+	}
+}
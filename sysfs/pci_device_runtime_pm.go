@@ -0,0 +1,77 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// PciRuntimePM contains runtime power management info from files in
+// /sys/bus/pci/devices/<Location>/power .
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-devices-power
+type PciRuntimePM struct {
+	Status        *string // /sys/bus/pci/devices/<Location>/power/runtime_status
+	SuspendedTime *uint64 // /sys/bus/pci/devices/<Location>/power/runtime_suspended_time, in milliseconds
+	ActiveTime    *uint64 // /sys/bus/pci/devices/<Location>/power/runtime_active_time, in milliseconds
+	Control       *string // /sys/bus/pci/devices/<Location>/power/control
+}
+
+// RuntimePM returns runtime power management info for a PCI device.
+// It returns nil if the device has no power directory.
+func (pci *PciDevice) RuntimePM(fs FS) (*PciRuntimePM, error) {
+	powerDir := fs.sys.Path(pciDevicesPath, pci.Location.DirectoryName(), "power")
+
+	if _, err := os.Stat(powerDir); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	pm := &PciRuntimePM{}
+	for _, f := range [...]string{"runtime_status", "runtime_suspended_time", "runtime_active_time", "control"} {
+		name := filepath.Join(powerDir, f)
+		valueStr, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		switch f {
+		case "runtime_status":
+			pm.Status = &valueStr
+		case "control":
+			pm.Control = &valueStr
+		case "runtime_suspended_time", "runtime_active_time":
+			value, err := strconv.ParseUint(valueStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s %q %s: %w", f, valueStr, pci.Location, err)
+			}
+			switch f {
+			case "runtime_suspended_time":
+				pm.SuspendedTime = &value
+			case "runtime_active_time":
+				pm.ActiveTime = &value
+			}
+		}
+	}
+
+	return pm, nil
+}
@@ -0,0 +1,92 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPciRuntimePM(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		statusActive    = "active"
+		statusSuspended = "suspended"
+		controlAuto     = "auto"
+		controlOn       = "on"
+
+		suspendedTime0    uint64
+		suspendedTime1520 uint64 = 1520
+		activeTime        uint64 = 3838519
+		activeTimeBridge  uint64 = 3838515
+	)
+
+	tests := []struct {
+		name string
+		want *PciRuntimePM
+	}{
+		{
+			name: "0000:00:02:1",
+			want: &PciRuntimePM{
+				Status:        &statusSuspended,
+				SuspendedTime: &suspendedTime1520,
+				ActiveTime:    &activeTimeBridge,
+				Control:       &controlAuto,
+			},
+		},
+		{
+			name: "0000:01:00:0",
+			want: &PciRuntimePM{
+				Status:        &statusActive,
+				SuspendedTime: &suspendedTime0,
+				ActiveTime:    &activeTime,
+				Control:       &controlOn,
+			},
+		},
+		{
+			name: "0000:a2:01:0",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device, ok := devices[tt.name]
+			if !ok {
+				t.Fatalf("device %s not found", tt.name)
+			}
+
+			got, err := device.RuntimePM(fs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("unexpected runtime PM (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"strconv"
+)
+
+// SetSriovNumvfs enables n SR-IOV virtual functions on a PCI physical
+// function by writing to /sys/bus/pci/devices/<Location>/sriov_numvfs .
+// The kernel rejects changing a non-zero number of VFs to another non-zero
+// value, so it must be set to 0 first. Unlike the other methods writing to
+// sysfs it does not require an FS created with NewWritableFS.
+func (pci *PciDevice) SetSriovNumvfs(fs FS, n uint32) error {
+	return writePciDeviceAttribute(fs, pci.Location, "sriov_numvfs", strconv.FormatUint(uint64(n), 10))
+}
+
+// SetSriovDriversAutoprobe controls whether newly created virtual functions
+// are probed by their drivers by writing to
+// /sys/bus/pci/devices/<Location>/sriov_drivers_autoprobe . Unlike the
+// other methods writing to sysfs it does not require an FS created with
+// NewWritableFS.
+func (pci *PciDevice) SetSriovDriversAutoprobe(fs FS, autoprobe bool) error {
+	value := "0"
+	if autoprobe {
+		value = "1"
+	}
+	return writePciDeviceAttribute(fs, pci.Location, "sriov_drivers_autoprobe", value)
+}
+
+// writePciDeviceAttribute writes value to an existing attribute file of the
+// PCI device. It never creates the file.
+func writePciDeviceAttribute(fs FS, location PciDeviceLocation, attr, value string) error {
+	return writeAttribute(fs.sys.Path(pciDevicesPath, location.DirectoryName(), attr), value)
+}
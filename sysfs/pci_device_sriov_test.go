@@ -0,0 +1,68 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+)
+
+func TestPciDeviceSetSriov(t *testing.T) {
+	tempDir := t.TempDir()
+
+	writeMockPciDevice(t, tempDir, "0000:00:03.0", map[string]string{
+		"class":                   "0x020000",
+		"sriov_numvfs":            "0",
+		"sriov_drivers_autoprobe": "1",
+	})
+	writeMockPciDevice(t, tempDir, "0000:00:04.0", map[string]string{"class": "0x020000"})
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pf := devices["0000:00:03:0"]
+	if err := pf.SetSriovNumvfs(fs, 16); err != nil {
+		t.Fatal(err)
+	}
+	if err := pf.SetSriovDriversAutoprobe(fs, false); err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err = fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := devices["0000:00:03:0"]
+	if got.SriovNumvfs == nil || *got.SriovNumvfs != 16 {
+		t.Errorf("unexpected sriov_numvfs: %v", got.SriovNumvfs)
+	}
+	if got.SriovDriversAutoprobe == nil || *got.SriovDriversAutoprobe {
+		t.Errorf("unexpected sriov_drivers_autoprobe: %v", got.SriovDriversAutoprobe)
+	}
+
+	// Devices without SR-IOV support must not get the attribute created.
+	noSriov := devices["0000:00:04:0"]
+	if err := noSriov.SetSriovNumvfs(fs, 1); err == nil {
+		t.Error("expected error setting sriov_numvfs on a device without SR-IOV")
+	}
+}
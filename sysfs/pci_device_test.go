@@ -16,6 +16,11 @@
 package sysfs
 
 import (
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -40,7 +45,7 @@ func TestPciDevices(t *testing.T) {
 
 		// SR-IOV test values
 		SriovDriversAutoprobe = true
-		SriovNumvfs           = uint32(0)
+		SriovNumvfs           = uint32(2)
 		SriovOffset           = uint32(8)
 		SriovStride           = uint32(1)
 		SriovTotalvfs         = uint32(128)
@@ -52,6 +57,22 @@ func TestPciDevices(t *testing.T) {
 		NumaNodeNeg1  = int32(-1)
 		D3coldAllowed = true
 		PowerState    = PciPowerStateD0
+
+		IommuGroup2  = 2
+		IommuGroup11 = 11
+
+		SerialNumber = uint64(0xb49691ffffa1b2c3)
+
+		Label = "NIC1"
+		Index = uint32(1)
+
+		// Bridge test values
+		SecondaryBus   = uint32(1)
+		SubordinateBus = uint32(1)
+
+		// ASPM link state test values
+		LinkPMEnabled  = true
+		LinkPMDisabled = false
 	)
 	want := PciDevices{
 		"0000:00:02:1": PciDevice{
@@ -78,6 +99,53 @@ func TestPciDevices(t *testing.T) {
 
 			D3coldAllowed: &D3coldAllowed,
 			PowerState:    &PowerState,
+
+			IommuGroup: &IommuGroup2,
+
+			ACS: &PciACS{
+				Capability: PciACSControls{
+					SourceValidation:    true,
+					TranslationBlocking: true,
+					RequestRedirect:     true,
+					CompletionRedirect:  true,
+					UpstreamForwarding:  true,
+				},
+				Control: PciACSControls{
+					SourceValidation:   true,
+					RequestRedirect:    true,
+					CompletionRedirect: true,
+					UpstreamForwarding: true,
+				},
+			},
+
+			Bridge: &PciBridgeInfo{
+				SecondaryBus:   &SecondaryBus,
+				SubordinateBus: &SubordinateBus,
+				MemoryWindow: &PciBridgeWindow{
+					Start: 0xfd800000,
+					End:   0xfd8fffff,
+					Flags: 0x200,
+				},
+			},
+
+			Uevent: &PciUevent{
+				Driver:          "pcieport",
+				Class:           0x060400,
+				Vendor:          0x1022,
+				Device:          0x1634,
+				SubsystemVendor: 0x17aa,
+				SubsystemDevice: 0x5095,
+				SlotName:        "0000:00:02.1",
+				Modalias:        "pci:v00001022d00001634sv000017AAsd00005095bc06sc04i00",
+				Values: map[string]string{
+					"DRIVER":        "pcieport",
+					"PCI_CLASS":     "60400",
+					"PCI_ID":        "1022:1634",
+					"PCI_SUBSYS_ID": "17AA:5095",
+					"PCI_SLOT_NAME": "0000:00:02.1",
+					"MODALIAS":      "pci:v00001022d00001634sv000017AAsd00005095bc06sc04i00",
+				},
+			},
 		},
 		"0000:01:00:0": PciDevice{
 			Location: PciDeviceLocation{
@@ -108,6 +176,35 @@ func TestPciDevices(t *testing.T) {
 
 			D3coldAllowed: &D3coldAllowed,
 			PowerState:    &PowerState,
+
+			IommuGroup: &IommuGroup11,
+
+			LinkPM: &PciLinkPM{
+				L0sASPM: &LinkPMDisabled,
+				L1ASPM:  &LinkPMEnabled,
+				L11ASPM: &LinkPMEnabled,
+				L12ASPM: &LinkPMDisabled,
+				ClkPM:   &LinkPMEnabled,
+			},
+
+			Uevent: &PciUevent{
+				Driver:          "nvme",
+				Class:           0x010802,
+				Vendor:          0xc0a9,
+				Device:          0x540a,
+				SubsystemVendor: 0xc0a9,
+				SubsystemDevice: 0x5021,
+				SlotName:        "0000:01:00.0",
+				Modalias:        "pci:v0000C0A9d0000540Asv0000C0A9sd00005021bc01sc08i02",
+				Values: map[string]string{
+					"DRIVER":        "nvme",
+					"PCI_CLASS":     "10802",
+					"PCI_ID":        "C0A9:540A",
+					"PCI_SUBSYS_ID": "C0A9:5021",
+					"PCI_SLOT_NAME": "0000:01:00.0",
+					"MODALIAS":      "pci:v0000C0A9d0000540Asv0000C0A9sd00005021bc01sc08i02",
+				},
+			},
 		},
 		"0000:a2:00:0": PciDevice{
 			Location: PciDeviceLocation{
@@ -143,6 +240,113 @@ func TestPciDevices(t *testing.T) {
 			// Power management fields
 			D3coldAllowed: &D3coldAllowed,
 			PowerState:    &PowerState,
+
+			SerialNumber: &SerialNumber,
+
+			Label:  &Label,
+			Index:  &Index,
+			HasROM: true,
+
+			Uevent: &PciUevent{
+				Driver:          "ice",
+				Class:           0x020000,
+				Vendor:          0x8086,
+				Device:          0x159b,
+				SubsystemVendor: 0x8086,
+				SubsystemDevice: 0x0003,
+				SlotName:        "0000:a2:00.0",
+				Modalias:        "pci:v00008086d0000159Bsv00008086sd00000003bc02sc00i00",
+				Values: map[string]string{
+					"DRIVER":        "ice",
+					"PCI_CLASS":     "20000",
+					"PCI_ID":        "8086:159B",
+					"PCI_SUBSYS_ID": "8086:0003",
+					"PCI_SLOT_NAME": "0000:a2:00.0",
+					"MODALIAS":      "pci:v00008086d0000159Bsv00008086sd00000003bc02sc00i00",
+				},
+			},
+		},
+		"0000:a2:01:0": PciDevice{
+			Location: PciDeviceLocation{
+				Segment:  0,
+				Bus:      0xa2,
+				Device:   1,
+				Function: 0,
+			},
+			ParentLocation: nil,
+
+			Class:           0x020000,
+			Vendor:          0x8086,
+			Device:          0x1889,
+			SubsystemVendor: 0x8086,
+			SubsystemDevice: 0x0000,
+			Revision:        0x02,
+			NumaNode:        &NumaNode,
+
+			PhysFn: &PciDeviceLocation{
+				Segment:  0,
+				Bus:      0xa2,
+				Device:   0,
+				Function: 0,
+			},
+
+			Uevent: &PciUevent{
+				Class:           0x020000,
+				Vendor:          0x8086,
+				Device:          0x1889,
+				SubsystemVendor: 0x8086,
+				SubsystemDevice: 0x0000,
+				SlotName:        "0000:a2:01.0",
+				Modalias:        "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				Values: map[string]string{
+					"PCI_CLASS":     "20000",
+					"PCI_ID":        "8086:1889",
+					"PCI_SUBSYS_ID": "8086:0000",
+					"PCI_SLOT_NAME": "0000:a2:01.0",
+					"MODALIAS":      "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				},
+			},
+		},
+		"0000:a2:01:1": PciDevice{
+			Location: PciDeviceLocation{
+				Segment:  0,
+				Bus:      0xa2,
+				Device:   1,
+				Function: 1,
+			},
+			ParentLocation: nil,
+
+			Class:           0x020000,
+			Vendor:          0x8086,
+			Device:          0x1889,
+			SubsystemVendor: 0x8086,
+			SubsystemDevice: 0x0000,
+			Revision:        0x02,
+			NumaNode:        &NumaNode,
+
+			PhysFn: &PciDeviceLocation{
+				Segment:  0,
+				Bus:      0xa2,
+				Device:   0,
+				Function: 0,
+			},
+
+			Uevent: &PciUevent{
+				Class:           0x020000,
+				Vendor:          0x8086,
+				Device:          0x1889,
+				SubsystemVendor: 0x8086,
+				SubsystemDevice: 0x0000,
+				SlotName:        "0000:a2:01.1",
+				Modalias:        "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				Values: map[string]string{
+					"PCI_CLASS":     "20000",
+					"PCI_ID":        "8086:1889",
+					"PCI_SUBSYS_ID": "8086:0000",
+					"PCI_SLOT_NAME": "0000:a2:01.1",
+					"MODALIAS":      "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				},
+			},
 		},
 	}
 
@@ -151,8 +355,156 @@ func TestPciDevices(t *testing.T) {
 	}
 }
 
+func TestPciDevicesWithOptionsWorkers(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, workers := range []int{0, 1, 2, 16} {
+		got, err := fs.PciDevicesWithOptions(PciDevicesOptions{Workers: workers})
+		if err != nil {
+			t.Fatalf("workers=%d: %v", workers, err)
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("unexpected PciDevices with %d workers (-want +got):\n%s", workers, diff)
+		}
+	}
+}
+
+// writeMockPciDevice creates a minimal PCI device directory below root with
+// the mandatory identification files, overridden or extended by files.
+func writeMockPciDevice(t *testing.T, root, name string, files map[string]string) {
+	t.Helper()
+
+	deviceDir := filepath.Join(root, "devices", "pci0000:00", name)
+	if err := os.MkdirAll(deviceDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	contents := map[string]string{
+		"class":            "0x060400",
+		"vendor":           "0x8086",
+		"device":           "0x1234",
+		"subsystem_vendor": "0x8086",
+		"subsystem_device": "0x0000",
+		"revision":         "0x01",
+	}
+	maps.Copy(contents, files)
+	for filename, content := range contents {
+		if err := os.WriteFile(filepath.Join(deviceDir, filename), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	linkDir := filepath.Join(root, "bus", "pci", "devices")
+	if err := os.MkdirAll(linkDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join("../../../devices/pci0000:00", name), filepath.Join(linkDir, name)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPciDeviceBootVGA(t *testing.T) {
+	tempDir := t.TempDir()
+
+	writeMockPciDevice(t, tempDir, "0000:00:08.0", map[string]string{"class": "0x030000", "boot_vga": "1"})
+	writeMockPciDevice(t, tempDir, "0000:00:09.0", map[string]string{"class": "0x030000", "boot_vga": "0"})
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]bool{"0000:00:08:0": true, "0000:00:09:0": false} {
+		got := devices[name].BootVGA
+		if got == nil || *got != want {
+			t.Errorf("unexpected boot_vga for %s: want %t, got %v", name, want, got)
+		}
+	}
+}
+
+func TestPciDevicesWithOptionsLenient(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Create one valid device and one device with a malformed class file.
+	writeMockPciDevice(t, tempDir, "0000:00:01.0", nil)
+	writeMockPciDevice(t, tempDir, "0000:00:02.0", map[string]string{"class": "bogus"})
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fs.PciDevices(); err == nil {
+		t.Fatal("expected strict parsing to fail on malformed class")
+	}
+
+	got, err := fs.PciDevicesWithOptions(PciDevicesOptions{Lenient: true})
+	if err == nil {
+		t.Fatal("expected lenient parsing to report the malformed device")
+	}
+
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) || len(joined.Unwrap()) != 1 {
+		t.Fatalf("expected exactly one per-device error, got %v", err)
+	}
+
+	if _, ok := got["0000:00:01:0"]; !ok || len(got) != 1 {
+		t.Fatalf("expected only device 0000:00:01:0 to be returned, got %v", got)
+	}
+}
+
+func TestPciDeviceVirtFns(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pf := devices["0000:a2:00:0"]
+	got, err := pf.VirtFns(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []PciDeviceLocation{
+		{Segment: 0, Bus: 0xa2, Device: 1, Function: 0},
+		{Segment: 0, Bus: 0xa2, Device: 1, Function: 1},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected VFs (-want +got):\n%s", diff)
+	}
+
+	vf := devices["0000:a2:01:0"]
+	got, err = vf.VirtFns(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("expected no VFs for a virtual function, got %v", got)
+	}
+}
+
 func TestParseDeviceLocation(t *testing.T) {
-	got, err := parsePciDeviceLocation("0001:9b:0c.0")
+	got, err := ParsePciDeviceLocation("0001:9b:0c.0")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -167,4 +519,123 @@ func TestParseDeviceLocation(t *testing.T) {
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Fatalf("unexpected location (-want +got):\n%s", diff)
 	}
+
+	if got.DirectoryName() != "0001:9b:0c.0" {
+		t.Errorf("unexpected directory name: %q", got.DirectoryName())
+	}
+	if got.String() != "0001:9b:0c:0" {
+		t.Errorf("unexpected string: %q", got.String())
+	}
+
+	for _, loc := range []string{"", "0000:00:00", "0000:00.00.0", "0000:zz:00.0"} {
+		if _, err := ParsePciDeviceLocation(loc); err == nil {
+			t.Errorf("expected error parsing location %q", loc)
+		}
+	}
+}
+
+func TestPciDevicesWithOptionsCanonicalKeys(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevicesWithOptions(PciDevicesOptions{CanonicalKeys: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"0000:00:02.1", "0000:01:00.0", "0000:a2:00.0", "0000:a2:01.0", "0000:a2:01.1"} {
+		device, ok := devices[name]
+		if !ok {
+			t.Fatalf("device %s not found", name)
+		}
+		if device.Location.DirectoryName() != name {
+			t.Errorf("unexpected location for %s: %s", name, device.Location.DirectoryName())
+		}
+	}
+}
+
+func TestPciDevicesByNumaNode(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodes, err := fs.PciDevicesByNumaNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[int32][]string{}
+	for node, devices := range nodes {
+		for _, device := range devices {
+			got[node] = append(got[node], device.Location.DirectoryName())
+		}
+	}
+
+	want := map[int32][]string{
+		-1: {"0000:00:02.1", "0000:01:00.0"},
+		1:  {"0000:a2:00.0", "0000:a2:01.0", "0000:a2:01.1"},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected PCI devices by NUMA node (-want +got):\n%s", diff)
+	}
+}
+
+func TestIterPciDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = fs.IterPciDevices(func(device PciDevice) error {
+		got = append(got, device.Location.DirectoryName())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"0000:00:02.1", "0000:01:00.0", "0000:a2:00.0", "0000:a2:01.0", "0000:a2:01.1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected PCI devices (-want +got):\n%s", diff)
+	}
+
+	// Filtered and concurrent iteration visits the matching devices in
+	// any order.
+	vendorIntel := uint32(0x8086)
+	got = nil
+	err = fs.IterPciDevicesWithOptions(PciDevicesOptions{
+		Filter:  PciFilter{Vendor: &vendorIntel},
+		Workers: 4,
+	}, func(device PciDevice) error {
+		got = append(got, device.Location.DirectoryName())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(got)
+
+	want = []string{"0000:a2:00.0", "0000:a2:01.0", "0000:a2:01.1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected filtered PCI devices (-want +got):\n%s", diff)
+	}
+
+	// An error returned by the callback stops the iteration.
+	errStop := errors.New("stop")
+	var count int
+	err = fs.IterPciDevices(func(PciDevice) error {
+		count++
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("expected callback error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected iteration to stop after 1 device, got %d", count)
+	}
 }
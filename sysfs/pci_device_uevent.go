@@ -0,0 +1,101 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PciUevent contains the parsed contents of
+// /sys/bus/pci/devices/<Location>/uevent .
+type PciUevent struct {
+	Driver          string // DRIVER, empty if no driver is bound
+	Class           uint32 // PCI_CLASS
+	Vendor          uint32 // PCI_ID
+	Device          uint32 // PCI_ID
+	SubsystemVendor uint32 // PCI_SUBSYS_ID
+	SubsystemDevice uint32 // PCI_SUBSYS_ID
+	SlotName        string // PCI_SLOT_NAME
+	Modalias        string // MODALIAS
+
+	// Values holds every KEY=VALUE pair of the file, including the ones
+	// decoded above.
+	Values map[string]string
+}
+
+// parsePciUevent parses the KEY=VALUE lines of a PCI device uevent file.
+func parsePciUevent(data []byte) (*PciUevent, error) {
+	uevent := &PciUevent{Values: map[string]string{}}
+
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid uevent line %q", line)
+		}
+		uevent.Values[key] = value
+
+		var err error
+		switch key {
+		case "DRIVER":
+			uevent.Driver = value
+		case "PCI_CLASS":
+			uevent.Class, err = parsePciUeventHex(value)
+		case "PCI_ID":
+			uevent.Vendor, uevent.Device, err = parsePciUeventIDPair(value)
+		case "PCI_SUBSYS_ID":
+			uevent.SubsystemVendor, uevent.SubsystemDevice, err = parsePciUeventIDPair(value)
+		case "PCI_SLOT_NAME":
+			uevent.SlotName = value
+		case "MODALIAS":
+			uevent.Modalias = value
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s %q: %w", key, value, err)
+		}
+	}
+
+	return uevent, nil
+}
+
+// parsePciUeventIDPair parses a "VVVV:DDDD" hex ID pair.
+func parsePciUeventIDPair(value string) (uint32, uint32, error) {
+	first, second, ok := strings.Cut(value, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid ID pair %q", value)
+	}
+	a, err := parsePciUeventHex(first)
+	if err != nil {
+		return 0, 0, err
+	}
+	b, err := parsePciUeventHex(second)
+	if err != nil {
+		return 0, 0, err
+	}
+	return a, b, nil
+}
+
+func parsePciUeventHex(value string) (uint32, error) {
+	v, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
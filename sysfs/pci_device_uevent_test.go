@@ -0,0 +1,106 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPciDeviceUevent(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		want *PciUevent
+	}{
+		{
+			name: "0000:01:00:0",
+			want: &PciUevent{
+				Driver:          "nvme",
+				Class:           0x010802,
+				Vendor:          0xc0a9,
+				Device:          0x540a,
+				SubsystemVendor: 0xc0a9,
+				SubsystemDevice: 0x5021,
+				SlotName:        "0000:01:00.0",
+				Modalias:        "pci:v0000C0A9d0000540Asv0000C0A9sd00005021bc01sc08i02",
+				Values: map[string]string{
+					"DRIVER":        "nvme",
+					"PCI_CLASS":     "10802",
+					"PCI_ID":        "C0A9:540A",
+					"PCI_SUBSYS_ID": "C0A9:5021",
+					"PCI_SLOT_NAME": "0000:01:00.0",
+					"MODALIAS":      "pci:v0000C0A9d0000540Asv0000C0A9sd00005021bc01sc08i02",
+				},
+			},
+		},
+		{
+			name: "0000:a2:01:0",
+			want: &PciUevent{
+				Class:           0x020000,
+				Vendor:          0x8086,
+				Device:          0x1889,
+				SubsystemVendor: 0x8086,
+				SubsystemDevice: 0x0000,
+				SlotName:        "0000:a2:01.0",
+				Modalias:        "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				Values: map[string]string{
+					"PCI_CLASS":     "20000",
+					"PCI_ID":        "8086:1889",
+					"PCI_SUBSYS_ID": "8086:0000",
+					"PCI_SLOT_NAME": "0000:a2:01.0",
+					"MODALIAS":      "pci:v00008086d00001889sv00008086sd00000000bc02sc00i00",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device, ok := devices[tt.name]
+			if !ok {
+				t.Fatalf("device %s not found", tt.name)
+			}
+
+			if diff := cmp.Diff(tt.want, device.Uevent); diff != "" {
+				t.Fatalf("unexpected uevent (-want +got):\n%s", diff)
+			}
+
+			// The uevent must agree with the individually read attributes.
+			if device.Uevent.Class != device.Class || device.Uevent.Vendor != device.Vendor || device.Uevent.Device != device.Device {
+				t.Fatalf("uevent does not match device attributes: %+v", device)
+			}
+		})
+	}
+}
+
+func TestParsePciUeventInvalid(t *testing.T) {
+	for _, data := range []string{"DRIVER", "PCI_ID=8086", "PCI_CLASS=zz"} {
+		if _, err := parsePciUevent([]byte(data)); err == nil {
+			t.Errorf("expected error parsing uevent %q", data)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const (
+	// Large resource data type tags, see PCI Local Bus Specification 3.0,
+	// section 6.4 and appendix I.
+	pciVpdTagIdentifier = 0x82
+	pciVpdTagReadOnly   = 0x90
+
+	// Small resource data type end tag name.
+	pciVpdTagNameEnd = 0xf
+)
+
+// PciVpd contains the identifier string and read-only keywords of the Vital
+// Product Data in /sys/bus/pci/devices/<Location>/vpd .
+type PciVpd struct {
+	Identifier        string            // identifier string, usually the product name
+	PartNumber        string            // PN keyword
+	EngineeringChange string            // EC keyword
+	SerialNumber      string            // SN keyword
+	ManufacturerID    string            // MN keyword
+	Vendor            map[string]string // V0 to VZ vendor specific keywords
+}
+
+// Vpd returns the Vital Product Data of a PCI device.
+// It returns nil if the device has no VPD or its VPD is not programmed.
+func (pci *PciDevice) Vpd(fs FS) (*PciVpd, error) {
+	path := fs.sys.Path(pciDevicesPath, pci.Location.DirectoryName(), "vpd")
+
+	data, err := util.ReadFileNoStat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	vpd, err := parsePciVpd(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse VPD %q: %w", path, err)
+	}
+
+	return vpd, nil
+}
+
+// parsePciVpd parses the VPD resource list up to the end tag. It returns nil
+// if the VPD is not programmed.
+func parsePciVpd(data []byte) (*PciVpd, error) {
+	// An unprogrammed VPD EEPROM reads as all 0xff or 0x00 bytes, neither of
+	// which is a valid first tag.
+	if len(data) == 0 || data[0] == 0xff || data[0] == 0x00 {
+		return nil, nil
+	}
+
+	vpd := &PciVpd{}
+
+	for off := 0; off < len(data); {
+		tag := data[off]
+
+		// Small resource: bit 7 clear, name in bits 6:3, length in bits 2:0.
+		if tag&0x80 == 0 {
+			if (tag>>3)&0xf == pciVpdTagNameEnd {
+				return vpd, nil
+			}
+			off += 1 + int(tag&0x7)
+			continue
+		}
+
+		// Large resource: tag byte followed by a 16-bit little endian length.
+		if off+3 > len(data) {
+			return nil, fmt.Errorf("truncated resource tag %#x at offset %d", tag, off)
+		}
+		start := off + 3
+		end := start + int(binary.LittleEndian.Uint16(data[off+1:]))
+		if end > len(data) {
+			return nil, fmt.Errorf("resource tag %#x at offset %d exceeds VPD size %d", tag, off, len(data))
+		}
+
+		switch tag {
+		case pciVpdTagIdentifier:
+			vpd.Identifier = trimPciVpdValue(data[start:end])
+		case pciVpdTagReadOnly:
+			if err := parsePciVpdKeywords(data[start:end], vpd); err != nil {
+				return nil, err
+			}
+		}
+
+		off = end
+	}
+
+	return vpd, nil
+}
+
+// parsePciVpdKeywords parses the keywords of the read-only VPD resource,
+// each a two character name, a length byte and the value.
+func parsePciVpdKeywords(data []byte, vpd *PciVpd) error {
+	for off := 0; off+3 <= len(data); {
+		keyword := string(data[off : off+2])
+		start := off + 3
+		end := start + int(data[off+2])
+		if end > len(data) {
+			return fmt.Errorf("keyword %q at offset %d exceeds resource size %d", keyword, off, len(data))
+		}
+		value := trimPciVpdValue(data[start:end])
+
+		switch {
+		case keyword == "PN":
+			vpd.PartNumber = value
+		case keyword == "EC":
+			vpd.EngineeringChange = value
+		case keyword == "SN":
+			vpd.SerialNumber = value
+		case keyword == "MN":
+			vpd.ManufacturerID = value
+		case keyword[0] == 'V':
+			if vpd.Vendor == nil {
+				vpd.Vendor = map[string]string{}
+			}
+			vpd.Vendor[keyword] = value
+		case keyword == "RV":
+			// The checksum keyword concludes the read-only resource.
+			return nil
+		}
+
+		off = end
+	}
+
+	return nil
+}
+
+func trimPciVpdValue(value []byte) string {
+	return strings.TrimRight(string(value), " \x00")
+}
@@ -0,0 +1,86 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPciDeviceVpd(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := fs.PciDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nic := devices["0000:a2:00:0"]
+	got, err := nic.Vpd(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PciVpd{
+		Identifier:        "Intel(R) Ethernet Network Adapter E810-XXVDA2",
+		PartNumber:        "K91258-010",
+		EngineeringChange: "K91258-005",
+		SerialNumber:      "6805CAC26E80",
+		Vendor: map[string]string{
+			"V1": "E810-XXVDA2",
+			"V2": "FW 4.20",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected VPD (-want +got):\n%s", diff)
+	}
+
+	// Devices without VPD return nil.
+	bridge := devices["0000:00:02:1"]
+	got, err = bridge.Vpd(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("expected no VPD, got %v", got)
+	}
+
+	// Unprogrammed VPD reads as all 0xff bytes and is reported as no VPD.
+	nvme := devices["0000:01:00:0"]
+	got, err = nvme.Vpd(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("expected no VPD for unprogrammed VPD, got %v", got)
+	}
+}
+
+func TestParsePciVpdInvalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"truncated tag":     {0x82, 0x10},
+		"truncated string":  {0x82, 0x10, 0x00, 'a'},
+		"truncated keyword": {0x90, 0x05, 0x00, 'P', 'N', 0x08, 'a', 'b'},
+	} {
+		if _, err := parsePciVpd(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const pciSlotsPath = "bus/pci/slots"
+
+// PciSlot contains info from files in /sys/bus/pci/slots for a single
+// physical PCI slot. Hotplug-capable slots additionally expose the power,
+// attention, latch and adapter files.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-bus-pci
+type PciSlot struct {
+	Name        string
+	Address     string  // /sys/bus/pci/slots/<Name>/address
+	Power       *bool   // /sys/bus/pci/slots/<Name>/power
+	Attention   *uint64 // /sys/bus/pci/slots/<Name>/attention
+	Latch       *bool   // /sys/bus/pci/slots/<Name>/latch
+	Adapter     *bool   // /sys/bus/pci/slots/<Name>/adapter
+	MaxBusSpeed *string // /sys/bus/pci/slots/<Name>/max_bus_speed
+	CurBusSpeed *string // /sys/bus/pci/slots/<Name>/cur_bus_speed
+}
+
+// PciSlots is a collection of every PCI slot in /sys/bus/pci/slots .
+//
+// The map keys are the slot names.
+type PciSlots map[string]PciSlot
+
+// PciSlots returns info for all PCI slots read from /sys/bus/pci/slots .
+func (fs FS) PciSlots() (PciSlots, error) {
+	path := fs.sys.Path(pciSlotsPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make(PciSlots, len(dirs))
+	for _, d := range dirs {
+		slot, err := fs.parsePciSlot(d.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		slots[slot.Name] = *slot
+	}
+
+	return slots, nil
+}
+
+func (fs FS) parsePciSlot(name string) (*PciSlot, error) {
+	path := fs.sys.Path(pciSlotsPath, name)
+	slot := &PciSlot{Name: name}
+
+	address, err := util.SysReadFile(filepath.Join(path, "address"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", filepath.Join(path, "address"), err)
+	}
+	slot.Address = address
+
+	for _, f := range [...]string{"power", "attention", "latch", "adapter", "max_bus_speed", "cur_bus_speed"} {
+		name := filepath.Join(path, f)
+		valueStr, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", name, err)
+		}
+
+		// Speeds are reported as 'Unknown' when no adapter is present.
+		if valueStr == "" || strings.HasPrefix(valueStr, "Unknown") {
+			continue
+		}
+
+		switch f {
+		case "power", "latch", "adapter":
+			value, err := strconv.ParseInt(valueStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s %q slot %s: %w", f, valueStr, slot.Name, err)
+			}
+			v := value != 0
+			switch f {
+			case "power":
+				slot.Power = &v
+			case "latch":
+				slot.Latch = &v
+			case "adapter":
+				slot.Adapter = &v
+			}
+
+		case "attention":
+			value, err := strconv.ParseUint(valueStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s %q slot %s: %w", f, valueStr, slot.Name, err)
+			}
+			slot.Attention = &value
+
+		case "max_bus_speed":
+			slot.MaxBusSpeed = &valueStr
+		case "cur_bus_speed":
+			slot.CurBusSpeed = &valueStr
+		}
+	}
+
+	return slot, nil
+}
@@ -0,0 +1,76 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPciSlots(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.PciSlots()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		enabled  = true
+		disabled = false
+
+		attention uint64
+
+		speed8GTs  = "8.0 GT/s PCIe"
+		speed16GTs = "16.0 GT/s PCIe"
+	)
+
+	want := PciSlots{
+		"1": {
+			Name:        "1",
+			Address:     "0000:a2:00",
+			Power:       &enabled,
+			Attention:   &attention,
+			Latch:       &enabled,
+			Adapter:     &enabled,
+			MaxBusSpeed: &speed16GTs,
+			CurBusSpeed: &speed16GTs,
+		},
+		"2": {
+			Name:        "2",
+			Address:     "0000:b3:00",
+			Power:       &disabled,
+			Attention:   &attention,
+			Latch:       &disabled,
+			Adapter:     &disabled,
+			MaxBusSpeed: &speed8GTs,
+		},
+		"3": {
+			Name:        "3",
+			Address:     "0000:01:00",
+			MaxBusSpeed: &speed8GTs,
+			CurBusSpeed: &speed8GTs,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected PCI slots (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const pstorePath = "fs/pstore"
+
+// PstoreEntry is a single record in /sys/fs/pstore . Records are named
+// <type>-<backend>-<id>, like dmesg-ramoops-0.
+// https://www.kernel.org/doc/Documentation/ABI/testing/pstore
+type PstoreEntry struct {
+	Name    string
+	Type    string // record type, e.g. dmesg, console or ftrace
+	Backend string // storage backend, e.g. ramoops or efi
+	ID      string
+	// Compressed is true for records the kernel failed to decompress,
+	// which carry an .enc.z suffix.
+	Compressed bool
+	// Time is when the record was written, as reported through the file
+	// modification time.
+	Time time.Time
+	Data []byte
+}
+
+// PstoreEntries is a collection of every record in /sys/fs/pstore .
+//
+// The map keys are the file names.
+type PstoreEntries map[string]PstoreEntry
+
+// Pstore returns all records in /sys/fs/pstore including their contents.
+// The pstore filesystem must be mounted there.
+func (fs FS) Pstore() (PstoreEntries, error) {
+	path := fs.sys.Path(pstorePath)
+
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pstore records at %q: %w", path, err)
+	}
+
+	entries := make(PstoreEntries, len(files))
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		entry, err := parsePstoreName(f.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		file := filepath.Join(path, f.Name())
+		info, err := f.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %q: %w", file, err)
+		}
+		entry.Time = info.ModTime()
+
+		entry.Data, err = os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		entries[entry.Name] = *entry
+	}
+
+	return entries, nil
+}
+
+// parsePstoreName splits a record name into its parts. Types may contain
+// dashes, like powerpc-ofw-nvram-0, so the name is split from the end.
+func parsePstoreName(name string) (*PstoreEntry, error) {
+	entry := &PstoreEntry{Name: name}
+
+	base, compressed := strings.CutSuffix(name, ".enc.z")
+	entry.Compressed = compressed
+
+	fields := strings.Split(base, "-")
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("invalid pstore record name %q", name)
+	}
+	entry.ID = fields[len(fields)-1]
+	entry.Backend = fields[len(fields)-2]
+	entry.Type = strings.Join(fields[:len(fields)-2], "-")
+
+	return entry, nil
+}
@@ -0,0 +1,107 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPstore(t *testing.T) {
+	tempDir := t.TempDir()
+
+	path := filepath.Join(tempDir, pstorePath)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	crash := time.Date(2024, 3, 14, 8, 21, 7, 0, time.UTC)
+	records := map[string]string{
+		"dmesg-ramoops-0":                 "Panic#1 Part1\n<0>[ 1234.567890] Kernel panic - not syncing: sysrq triggered crash\n",
+		"console-ramoops-0":               "[    0.000000] Linux version 6.8.0\n",
+		"dmesg-efi-171440406701001.enc.z": "\x78\x9c",
+	}
+	for name, data := range records {
+		file := filepath.Join(path, name)
+		if err := os.WriteFile(file, []byte(data), 0o444); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(file, crash, crash); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.Pstore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PstoreEntries{
+		"dmesg-ramoops-0": {
+			Name:    "dmesg-ramoops-0",
+			Type:    "dmesg",
+			Backend: "ramoops",
+			ID:      "0",
+			Time:    crash,
+			Data:    []byte(records["dmesg-ramoops-0"]),
+		},
+		"console-ramoops-0": {
+			Name:    "console-ramoops-0",
+			Type:    "console",
+			Backend: "ramoops",
+			ID:      "0",
+			Time:    crash,
+			Data:    []byte(records["console-ramoops-0"]),
+		},
+		"dmesg-efi-171440406701001.enc.z": {
+			Name:       "dmesg-efi-171440406701001.enc.z",
+			Type:       "dmesg",
+			Backend:    "efi",
+			ID:         "171440406701001",
+			Compressed: true,
+			Time:       crash,
+			Data:       []byte("\x78\x9c"),
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Fatalf("unexpected pstore entries (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePstoreName(t *testing.T) {
+	got, err := parsePstoreName("powerpc-ofw-nvram-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PstoreEntry{Name: "powerpc-ofw-nvram-0", Type: "powerpc-ofw", Backend: "nvram", ID: "0"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected pstore entry (-want +got):\n%s", diff)
+	}
+
+	if _, err := parsePstoreName("dmesg-0"); err == nil {
+		t.Error("expected error parsing a name without backend")
+	}
+}
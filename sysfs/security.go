@@ -0,0 +1,48 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// LSMs returns the names of the active Linux Security Modules in the order
+// they are invoked, read from /sys/kernel/security/lsm . securityfs must be
+// mounted for the file to exist.
+func (fs FS) LSMs() ([]string, error) {
+	file := fs.sys.Path("kernel/security/lsm")
+	data, err := util.ReadFileNoStat(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+	}
+
+	return parseLSMs(string(data)), nil
+}
+
+// parseLSMs parses a comma separated module list like
+// "lockdown,capability,yama,selinux".
+func parseLSMs(data string) []string {
+	var lsms []string
+	for name := range strings.SplitSeq(strings.TrimSpace(data), ",") {
+		if name != "" {
+			lsms = append(lsms, name)
+		}
+	}
+	return lsms
+}
@@ -0,0 +1,54 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLSMs(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.LSMs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"lockdown", "capability", "landlock", "yama", "selinux", "bpf"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected LSMs (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseLSMs(t *testing.T) {
+	for _, tc := range []struct {
+		data string
+		want []string
+	}{
+		{data: "capability,apparmor\n", want: []string{"capability", "apparmor"}},
+		{data: "capability,", want: []string{"capability"}},
+		{data: "", want: nil},
+	} {
+		if diff := cmp.Diff(tc.want, parseLSMs(tc.data)); diff != "" {
+			t.Errorf("unexpected LSMs for %q (-want +got):\n%s", tc.data, diff)
+		}
+	}
+}
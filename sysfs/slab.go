@@ -0,0 +1,175 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const slabPath = "kernel/slab"
+
+// SlabCount is a SLUB counter with its per-NUMA-node breakdown, from files
+// like "objects" containing "12345 N0=6000 N1=6345".
+type SlabCount struct {
+	Total uint64
+	// Nodes holds the counter per NUMA node keyed by node ID.
+	Nodes map[int]uint64
+}
+
+// SlabCache contains info from files in /sys/kernel/slab/<Name> for a single
+// SLUB cache.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-kernel-slab
+type SlabCache struct {
+	Name        string
+	ObjectSize  *uint64    // /sys/kernel/slab/<Name>/object_size
+	SlabSize    *uint64    // /sys/kernel/slab/<Name>/slab_size
+	ObjsPerSlab *uint64    // /sys/kernel/slab/<Name>/objs_per_slab
+	Order       *uint64    // /sys/kernel/slab/<Name>/order
+	Aliases     *uint64    // /sys/kernel/slab/<Name>/aliases, number of caches merged into this one
+	CPUPartial  *uint64    // /sys/kernel/slab/<Name>/cpu_partial
+	Objects     *SlabCount // /sys/kernel/slab/<Name>/objects, objects in use
+	Slabs       *SlabCount // /sys/kernel/slab/<Name>/slabs
+}
+
+// SlabCaches is a collection of every SLUB cache in /sys/kernel/slab .
+// Caches merged into another one share its values.
+//
+// The map keys are the cache names.
+type SlabCaches map[string]SlabCache
+
+// SlabSysfs returns info for all SLUB caches read from /sys/kernel/slab .
+// SLUB makes most of the attributes readable by root only; attributes the
+// caller may not read are left nil.
+func (fs FS) SlabSysfs() (SlabCaches, error) {
+	path := fs.sys.Path(slabPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list slab caches at %q: %w", path, err)
+	}
+
+	caches := make(SlabCaches, len(dirs))
+	for _, d := range dirs {
+		// Merged caches are directories with generated names like
+		// ":a-0000104" that the named caches link to.
+		if strings.HasPrefix(d.Name(), ":") {
+			continue
+		}
+
+		cache, err := fs.parseSlabCache(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		caches[cache.Name] = *cache
+	}
+
+	return caches, nil
+}
+
+func (fs FS) parseSlabCache(name string) (*SlabCache, error) {
+	path := fs.sys.Path(slabPath, name)
+	cache := &SlabCache{Name: name}
+
+	for _, f := range [...]string{"object_size", "slab_size", "objs_per_slab", "order", "aliases", "cpu_partial", "objects", "slabs"} {
+		file := filepath.Join(path, f)
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+		value := strings.TrimSpace(string(data))
+
+		switch f {
+		case "objects", "slabs":
+			count, err := parseSlabCount(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+			}
+			if f == "objects" {
+				cache.Objects = count
+			} else {
+				cache.Slabs = count
+			}
+			continue
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "object_size":
+			cache.ObjectSize = vp.PUInt64()
+		case "slab_size":
+			cache.SlabSize = vp.PUInt64()
+		case "objs_per_slab":
+			cache.ObjsPerSlab = vp.PUInt64()
+		case "order":
+			cache.Order = vp.PUInt64()
+		case "aliases":
+			cache.Aliases = vp.PUInt64()
+		case "cpu_partial":
+			cache.CPUPartial = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return cache, nil
+}
+
+func parseSlabCount(value string) (*SlabCount, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, errors.New("empty slab counter")
+	}
+
+	total, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	count := &SlabCount{Total: total}
+
+	for _, field := range fields[1:] {
+		node, v, ok := strings.Cut(field, "=")
+		if !ok || !strings.HasPrefix(node, "N") {
+			return nil, fmt.Errorf("invalid node counter %q", field)
+		}
+		id, err := strconv.Atoi(node[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid node counter %q: %w", field, err)
+		}
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node counter %q: %w", field, err)
+		}
+		if count.Nodes == nil {
+			count.Nodes = map[int]uint64{}
+		}
+		count.Nodes[id] = n
+	}
+
+	return count, nil
+}
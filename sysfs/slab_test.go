@@ -0,0 +1,125 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSlabSysfs(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.SlabSysfs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	merged := func(name string) SlabCache {
+		return SlabCache{
+			Name:        name,
+			ObjectSize:  makeUint64(64),
+			SlabSize:    makeUint64(64),
+			ObjsPerSlab: makeUint64(64),
+			Order:       makeUint64(0),
+			Aliases:     makeUint64(3),
+			CPUPartial:  makeUint64(120),
+			Objects:     &SlabCount{Total: 52870, Nodes: map[int]uint64{0: 26420, 1: 26450}},
+			Slabs:       &SlabCount{Total: 831, Nodes: map[int]uint64{0: 415, 1: 416}},
+		}
+	}
+
+	want := SlabCaches{
+		"anon_vma_chain":  merged("anon_vma_chain"),
+		"kmalloc-64":      merged("kmalloc-64"),
+		"kmem_cache_node": merged("kmem_cache_node"),
+		"dentry": {
+			Name:        "dentry",
+			ObjectSize:  makeUint64(192),
+			SlabSize:    makeUint64(192),
+			ObjsPerSlab: makeUint64(21),
+			Order:       makeUint64(0),
+			Aliases:     makeUint64(0),
+			CPUPartial:  makeUint64(120),
+			Objects:     &SlabCount{Total: 401247, Nodes: map[int]uint64{0: 200101, 1: 201146}},
+			Slabs:       &SlabCount{Total: 19107, Nodes: map[int]uint64{0: 9529, 1: 9578}},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected slab caches (-want +got):\n%s", diff)
+	}
+}
+
+func TestSlabSysfsUnreadableAttribute(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can read files regardless of their mode")
+	}
+
+	tempDir := t.TempDir()
+	dir := filepath.Join(tempDir, "kernel/slab/dentry")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"object_size", "slabs"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("192\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// SLUB creates most attributes with mode 0400, owned by root.
+	if err := os.Chmod(filepath.Join(dir, "slabs"), 0o000); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.SlabSysfs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := SlabCaches{
+		"dentry": SlabCache{Name: "dentry", ObjectSize: makeUint64(192)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected slab caches (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseSlabCount(t *testing.T) {
+	got, err := parseSlabCount("42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&SlabCount{Total: 42}, got); diff != "" {
+		t.Errorf("unexpected slab count (-want +got):\n%s", diff)
+	}
+
+	for _, value := range []string{"", "x", "1 0=1", "1 Nx=1", "1 N0=y"} {
+		if _, err := parseSlabCount(value); err == nil {
+			t.Errorf("expected error parsing %q", value)
+		}
+	}
+}
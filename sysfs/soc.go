@@ -0,0 +1,145 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// SocDevice contains info from files in /sys/devices/soc<N> for a single
+// system-on-chip.
+// https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-devices-soc
+type SocDevice struct {
+	Name         string
+	Machine      *string // /sys/devices/<Name>/machine
+	Family       *string // /sys/devices/<Name>/family
+	Revision     *string // /sys/devices/<Name>/revision
+	SerialNumber *string // /sys/devices/<Name>/serial_number
+	SocID        *string // /sys/devices/<Name>/soc_id
+}
+
+// SocDevices is a collection of every SoC in /sys/devices/soc<N> .
+//
+// The map keys are the device names, like "soc0".
+type SocDevices map[string]SocDevice
+
+// DeviceTree contains the identity of the board from
+// /sys/firmware/devicetree/base .
+type DeviceTree struct {
+	Model      *string  // /sys/firmware/devicetree/base/model
+	Compatible []string // /sys/firmware/devicetree/base/compatible, most specific first
+}
+
+// SocDevices returns info for all SoCs read from /sys/devices/soc<N> .
+func (fs FS) SocDevices() (SocDevices, error) {
+	paths, err := filepath.Glob(fs.sys.Path("devices/soc[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(SocDevices, len(paths))
+	for _, path := range paths {
+		device, err := parseSocDevice(path)
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func parseSocDevice(path string) (*SocDevice, error) {
+	device := &SocDevice{Name: filepath.Base(path)}
+
+	for _, f := range [...]string{"machine", "family", "revision", "serial_number", "soc_id"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		switch f {
+		case "machine":
+			device.Machine = &value
+		case "family":
+			device.Family = &value
+		case "revision":
+			device.Revision = &value
+		case "serial_number":
+			device.SerialNumber = &value
+		case "soc_id":
+			device.SocID = &value
+		}
+	}
+
+	return device, nil
+}
+
+// DeviceTree returns the board model and compatible strings read from
+// /sys/firmware/devicetree/base . Systems booted without a device tree, like
+// most x86 and ACPI based ARM servers, return an error wrapping
+// os.ErrNotExist.
+func (fs FS) DeviceTree() (*DeviceTree, error) {
+	path := fs.sys.Path("firmware/devicetree/base")
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	dt := &DeviceTree{}
+	for _, f := range [...]string{"model", "compatible"} {
+		file := filepath.Join(path, f)
+		data, err := util.ReadFileNoStat(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		// Device tree string properties are NUL terminated, string lists are
+		// NUL separated.
+		values := parseDeviceTreeStrings(data)
+		switch f {
+		case "model":
+			if len(values) > 0 {
+				dt.Model = &values[0]
+			}
+		case "compatible":
+			dt.Compatible = values
+		}
+	}
+
+	return dt, nil
+}
+
+func parseDeviceTreeStrings(data []byte) []string {
+	var values []string
+	for value := range strings.SplitSeq(string(data), "\x00") {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
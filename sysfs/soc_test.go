@@ -0,0 +1,76 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSocDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.SocDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := SocDevices{
+		"soc0": {
+			Name:         "soc0",
+			Machine:      makeString("Raspberry Pi 4 Model B Rev 1.4"),
+			Family:       makeString("BCM2835"),
+			Revision:     makeString("d03114"),
+			SerialNumber: makeString("100000003a7c9f21"),
+		},
+		"soc1": {
+			Name:     "soc1",
+			Family:   makeString("jep106:0070"),
+			Revision: makeString("0x00000001"),
+			SocID:    makeString("jep106:0070:0101"),
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected SoC devices (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeviceTree(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.DeviceTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := "Raspberry Pi 4 Model B Rev 1.4"
+	want := &DeviceTree{
+		Model:      &model,
+		Compatible: []string{"raspberrypi,4-model-b", "brcm,bcm2711"},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected device tree (-want +got):\n%s", diff)
+	}
+}
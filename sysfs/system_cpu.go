@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -41,6 +42,20 @@ type CPUTopology struct {
 	CoreSiblingsList   string
 	PhysicalPackageID  string
 	ThreadSiblingsList string
+	DieID              string // Optional, empty on kernels before 5.2.
+	ClusterID          string // Optional, empty on kernels before 5.16.
+}
+
+// CPUCache contains data located in `/sys/devices/system/cpu/cpu[0-9]*/cache/index[0-9]*`.
+type CPUCache struct {
+	Index               string  // N of the indexN directory.
+	ID                  *uint64 // Optional, unique among caches of the same level and type.
+	Level               uint64
+	Type                string // One of "Data", "Instruction" or "Unified".
+	Size                uint64 // Size in bytes.
+	SharedCPUList       string
+	CoherencyLineSize   *uint64
+	WaysOfAssociativity *uint64
 }
 
 // CPUThermalThrottle contains data from `/sys/devices/system/cpu/cpu[0-9]*/thermal_throttle`.
@@ -59,11 +74,15 @@ type SystemCPUCpufreqStats struct {
 	ScalingCurrentFrequency  *uint64
 	ScalingMinimumFrequency  *uint64
 	ScalingMaximumFrequency  *uint64
+	BaseFrequency            *uint64 // intel_pstate only
 	AvailableGovernors       string
 	Driver                   string
 	Governor                 string
 	RelatedCpus              string
 	SetSpeed                 string
+	// EnergyPerformancePreference is the EPP hint of intel_pstate and
+	// amd-pstate in active mode, like "balance_performance".
+	EnergyPerformancePreference string
 	// Refer `CONFIG_CPU_FREQ_STAT`: https://www.kernel.org/doc/html/latest/cpu-freq/cpufreq-stats.html#configuring-cpufreq-stats
 	CpuinfoFrequencyDuration         *map[uint64]uint64
 	CpuinfoFrequencyTransitionsTotal *uint64
@@ -115,9 +134,104 @@ func parseCPUTopology(cpuPath string) (*CPUTopology, error) {
 	if err != nil {
 		return nil, err
 	}
+	t.DieID, err = util.SysReadFile(filepath.Join(cpuPath, "die_id"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	t.ClusterID, err = util.SysReadFile(filepath.Join(cpuPath, "cluster_id"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
 	return &t, nil
 }
 
+// Caches gets the cache hierarchy of a single CPU from `/sys/devices/system/cpu/cpuN/cache`.
+func (c CPU) Caches() ([]CPUCache, error) {
+	cachePaths, err := filepath.Glob(filepath.Join(string(c), "cache", "index[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	caches := make([]CPUCache, 0, len(cachePaths))
+	for _, cachePath := range cachePaths {
+		cache, err := parseCPUCache(cachePath)
+		if err != nil {
+			return nil, err
+		}
+		caches = append(caches, *cache)
+	}
+
+	// Glob sorts lexically, which puts index10 before index2.
+	slices.SortFunc(caches, func(a, b CPUCache) int {
+		ai, _ := strconv.Atoi(a.Index)
+		bi, _ := strconv.Atoi(b.Index)
+		return ai - bi
+	})
+
+	return caches, nil
+}
+
+func parseCPUCache(cachePath string) (*CPUCache, error) {
+	cache := CPUCache{Index: strings.TrimPrefix(filepath.Base(cachePath), "index")}
+
+	for _, f := range [...]string{"id", "level", "type", "size", "shared_cpu_list", "coherency_line_size", "ways_of_associativity"} {
+		name := filepath.Join(cachePath, f)
+		value, err := util.SysReadFile(name)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		vp := util.NewValueParser(value)
+		switch f {
+		case "id":
+			cache.ID = vp.PUInt64()
+		case "level":
+			cache.Level = uint64(vp.Int())
+		case "type":
+			cache.Type = value
+		case "size":
+			cache.Size, err = parseCPUCacheSize(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+			}
+		case "shared_cpu_list":
+			cache.SharedCPUList = value
+		case "coherency_line_size":
+			cache.CoherencyLineSize = vp.PUInt64()
+		case "ways_of_associativity":
+			cache.WaysOfAssociativity = vp.PUInt64()
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", name, err)
+		}
+	}
+
+	return &cache, nil
+}
+
+// parseCPUCacheSize parses a cache size like "32K" into bytes.
+func parseCPUCacheSize(value string) (uint64, error) {
+	multiplier := uint64(1)
+	switch {
+	case strings.HasSuffix(value, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(value, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(value, "G"):
+		multiplier = 1 << 30
+	}
+
+	size, err := strconv.ParseUint(strings.TrimRight(value, "KMG"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return size * multiplier, nil
+}
+
 // ThermalThrottle gets the cpu throttle count information for a single CPU from `/sys/devices/system/cpu/cpuN/thermal_throttle`.
 func (c CPU) ThermalThrottle() (*CPUThermalThrottle, error) {
 	cpuPath := filepath.Join(string(c), "thermal_throttle")
@@ -275,6 +389,7 @@ func parseCpufreqCpuinfo(cpuPath string) (*SystemCPUCpufreqStats, error) {
 		"scaling_cur_freq",
 		"scaling_max_freq",
 		"scaling_min_freq",
+		"base_frequency",
 	}
 	uintOut := make([]*uint64, len(uintFiles))
 
@@ -307,6 +422,11 @@ func parseCpufreqCpuinfo(cpuPath string) (*SystemCPUCpufreqStats, error) {
 		}
 	}
 
+	energyPerformancePreference, err := util.SysReadFile(filepath.Join(cpuPath, "energy_performance_preference"))
+	if err != nil && !os.IsNotExist(err) && !os.IsPermission(err) {
+		return &SystemCPUCpufreqStats{}, err
+	}
+
 	// "total_trans" is the total number of times the CPU has changed frequency.
 	var cpuinfoFrequencyTransitionsTotal *uint64
 	cpuinfoFrequencyTransitionsTotalUint, err := util.ReadUintFromFile(filepath.Join(cpuPath, "stats", "total_trans"))
@@ -386,11 +506,13 @@ func parseCpufreqCpuinfo(cpuPath string) (*SystemCPUCpufreqStats, error) {
 		ScalingCurrentFrequency:          uintOut[4],
 		ScalingMaximumFrequency:          uintOut[5],
 		ScalingMinimumFrequency:          uintOut[6],
+		BaseFrequency:                    uintOut[7],
 		AvailableGovernors:               stringOut[0],
 		Driver:                           stringOut[1],
 		Governor:                         stringOut[2],
 		RelatedCpus:                      stringOut[3],
 		SetSpeed:                         stringOut[4],
+		EnergyPerformancePreference:      energyPerformancePreference,
 		CpuinfoFrequencyDuration:         cpuinfoFrequencyDuration,
 		CpuinfoFrequencyTransitionsTotal: cpuinfoFrequencyTransitionsTotal,
 		CpuinfoTransitionTable:           cpuinfoTransitionTable,
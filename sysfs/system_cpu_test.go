@@ -23,10 +23,6 @@ import (
 	"github.com/google/go-cmp/cmp"
 )
 
-func makeUint64(v uint64) *uint64 {
-	return &v
-}
-
 func TestCPUTopology(t *testing.T) {
 	fs, err := NewFS(sysTestFixtures)
 	if err != nil {
@@ -62,6 +58,47 @@ func TestCPUTopology(t *testing.T) {
 	if want, have := "1,5", cpu1Topology.ThreadSiblingsList; want != have {
 		t.Errorf("incorrect thread siblings list, have %v, want %v", want, have)
 	}
+	if want, have := "0", cpu0Topology.DieID; want != have {
+		t.Errorf("incorrect die ID, have %v, want %v", want, have)
+	}
+	if want, have := "", cpu1Topology.ClusterID; want != have {
+		t.Errorf("incorrect cluster ID, have %v, want %v", want, have)
+	}
+}
+
+func TestCPUCaches(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cpus, err := fs.CPUs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caches, err := cpus[0].Caches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []CPUCache{
+		{Index: "0", ID: makeUint64(0), Level: 1, Type: "Data", Size: 48 << 10, SharedCPUList: "0,4", CoherencyLineSize: makeUint64(64), WaysOfAssociativity: makeUint64(12)},
+		{Index: "1", ID: makeUint64(0), Level: 1, Type: "Instruction", Size: 32 << 10, SharedCPUList: "0,4", CoherencyLineSize: makeUint64(64), WaysOfAssociativity: makeUint64(8)},
+		{Index: "2", ID: makeUint64(0), Level: 2, Type: "Unified", Size: 1280 << 10, SharedCPUList: "0,4", CoherencyLineSize: makeUint64(64), WaysOfAssociativity: makeUint64(10)},
+		{Index: "3", ID: makeUint64(0), Level: 3, Type: "Unified", Size: 12288 << 10, SharedCPUList: "0-7", CoherencyLineSize: makeUint64(64), WaysOfAssociativity: makeUint64(12)},
+	}
+	if diff := cmp.Diff(want, caches); diff != "" {
+		t.Fatalf("unexpected CPU caches (-want +got):\n%s", diff)
+	}
+
+	// CPUs without cache info return no caches.
+	caches, err = cpus[1].Caches()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(caches) != 0 {
+		t.Errorf("expected no caches, got %v", caches)
+	}
 }
 
 func TestCPUOnline(t *testing.T) {
@@ -146,11 +183,13 @@ func TestSystemCpufreq(t *testing.T) {
 			ScalingCurrentFrequency:          makeUint64(1219917),
 			ScalingMinimumFrequency:          makeUint64(800000),
 			ScalingMaximumFrequency:          makeUint64(2400000),
+			BaseFrequency:                    makeUint64(2100000),
 			AvailableGovernors:               "performance powersave",
 			Driver:                           "intel_pstate",
 			Governor:                         "powersave",
 			RelatedCpus:                      "0",
 			SetSpeed:                         "<unsupported>",
+			EnergyPerformancePreference:      "balance_performance",
 			CpuinfoFrequencyDuration:         nil,
 			CpuinfoFrequencyTransitionsTotal: nil,
 			CpuinfoTransitionTable: &[][]uint64{
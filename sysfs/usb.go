@@ -0,0 +1,179 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const usbDevicesPath = "bus/usb/devices"
+
+// UsbDevice contains info from files in /sys/bus/usb/devices for a single
+// USB device. Interfaces of the devices are skipped.
+// https://www.kernel.org/doc/Documentation/ABI/stable/sysfs-bus-usb
+type UsbDevice struct {
+	Name         string
+	VendorID     *string  // /sys/bus/usb/devices/<Name>/idVendor
+	ProductID    *string  // /sys/bus/usb/devices/<Name>/idProduct
+	Manufacturer *string  // /sys/bus/usb/devices/<Name>/manufacturer
+	Product      *string  // /sys/bus/usb/devices/<Name>/product
+	Serial       *string  // /sys/bus/usb/devices/<Name>/serial
+	Version      *string  // /sys/bus/usb/devices/<Name>/version
+	Speed        *float64 // /sys/bus/usb/devices/<Name>/speed, in Mbit/s
+	BusNum       *uint64  // /sys/bus/usb/devices/<Name>/busnum
+	DevNum       *uint64  // /sys/bus/usb/devices/<Name>/devnum
+	MaxChild     *uint64  // /sys/bus/usb/devices/<Name>/maxchild
+
+	PowerControl       *string // /sys/bus/usb/devices/<Name>/power/control
+	AutosuspendDelayMs *int64  // /sys/bus/usb/devices/<Name>/power/autosuspend_delay_ms
+	PowerRuntimeStatus *string // /sys/bus/usb/devices/<Name>/power/runtime_status
+
+	// Parent is the name of the hub the device is attached to, empty for
+	// root hubs.
+	Parent string
+	// Children are the names of the devices attached to this hub.
+	Children []string
+}
+
+// UsbDevices is a collection of every USB device in /sys/bus/usb/devices .
+//
+// The map keys are the device names, like "usb1" or "1-1.2".
+type UsbDevices map[string]UsbDevice
+
+// UsbDevices returns info for all USB devices read from
+// /sys/bus/usb/devices .
+func (fs FS) UsbDevices() (UsbDevices, error) {
+	path := fs.sys.Path(usbDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(UsbDevices, len(dirs))
+	for _, d := range dirs {
+		// Interfaces are named <device>:<config>.<interface>.
+		if strings.Contains(d.Name(), ":") {
+			continue
+		}
+
+		device, err := fs.parseUsbDevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	for name, device := range devices {
+		if device.Parent == "" {
+			continue
+		}
+		if parent, ok := devices[device.Parent]; ok {
+			parent.Children = append(parent.Children, name)
+			devices[device.Parent] = parent
+		}
+	}
+	for name, device := range devices {
+		slices.Sort(device.Children)
+		devices[name] = device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseUsbDevice(name string) (*UsbDevice, error) {
+	path := fs.sys.Path(usbDevicesPath, name)
+	device := &UsbDevice{Name: name, Parent: usbParentName(name)}
+
+	for _, f := range [...]string{
+		"idVendor", "idProduct", "manufacturer", "product", "serial", "version",
+		"speed", "busnum", "devnum", "maxchild",
+		"power/control", "power/autosuspend_delay_ms", "power/runtime_status",
+	} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		vp := util.NewValueParser(value)
+
+		switch f {
+		case "idVendor":
+			device.VendorID = &value
+		case "idProduct":
+			device.ProductID = &value
+		case "manufacturer":
+			device.Manufacturer = &value
+		case "product":
+			device.Product = &value
+		case "serial":
+			device.Serial = &value
+		case "version":
+			device.Version = &value
+		case "speed":
+			// Low speed devices report "1.5", devices in an unknown state
+			// report "unknown".
+			if speed, err := strconv.ParseFloat(value, 64); err == nil {
+				device.Speed = &speed
+			}
+		case "busnum":
+			device.BusNum = vp.PUInt64()
+		case "devnum":
+			device.DevNum = vp.PUInt64()
+		case "maxchild":
+			device.MaxChild = vp.PUInt64()
+		case "power/control":
+			device.PowerControl = &value
+		case "power/autosuspend_delay_ms":
+			device.AutosuspendDelayMs = vp.PInt64()
+		case "power/runtime_status":
+			device.PowerRuntimeStatus = &value
+		}
+
+		if err := vp.Err(); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+	}
+
+	return device, nil
+}
+
+// usbParentName returns the name of the hub a device is attached to. Device
+// names are <bus>-<port>[.<port>...], so "1-1.2" is attached to "1-1" and
+// "1-1" to the root hub "usb1".
+func usbParentName(name string) string {
+	if strings.HasPrefix(name, "usb") {
+		return ""
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	if bus, _, ok := strings.Cut(name, "-"); ok {
+		return "usb" + bus
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsbDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.UsbDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := UsbDevices{
+		"usb1": {
+			Name:               "usb1",
+			VendorID:           makeString("1d6b"),
+			ProductID:          makeString("0002"),
+			Manufacturer:       makeString("Linux 6.8.0 xhci-hcd"),
+			Product:            makeString("xHCI Host Controller"),
+			Serial:             makeString("0000:00:14.0"),
+			Version:            makeString("2.00"),
+			Speed:              makeFloat64(480),
+			BusNum:             makeUint64(1),
+			DevNum:             makeUint64(1),
+			MaxChild:           makeUint64(12),
+			PowerControl:       makeString("auto"),
+			AutosuspendDelayMs: makeInt64(0),
+			PowerRuntimeStatus: makeString("active"),
+			Children:           []string{"1-1"},
+		},
+		"1-1": {
+			Name:               "1-1",
+			VendorID:           makeString("05e3"),
+			ProductID:          makeString("0610"),
+			Manufacturer:       makeString("GenesysLogic"),
+			Product:            makeString("USB2.1 Hub"),
+			Version:            makeString("2.10"),
+			Speed:              makeFloat64(480),
+			BusNum:             makeUint64(1),
+			DevNum:             makeUint64(2),
+			MaxChild:           makeUint64(4),
+			PowerControl:       makeString("auto"),
+			AutosuspendDelayMs: makeInt64(2000),
+			PowerRuntimeStatus: makeString("active"),
+			Parent:             "usb1",
+			Children:           []string{"1-1.2"},
+		},
+		"1-1.2": {
+			Name:               "1-1.2",
+			VendorID:           makeString("046d"),
+			ProductID:          makeString("c52b"),
+			Manufacturer:       makeString("Logitech"),
+			Product:            makeString("USB Receiver"),
+			Version:            makeString("2.00"),
+			Speed:              makeFloat64(12),
+			BusNum:             makeUint64(1),
+			DevNum:             makeUint64(3),
+			MaxChild:           makeUint64(0),
+			PowerControl:       makeString("on"),
+			AutosuspendDelayMs: makeInt64(2000),
+			PowerRuntimeStatus: makeString("active"),
+			Parent:             "1-1",
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected UsbDevices (-want +got):\n%s", diff)
+	}
+}
+
+func TestUsbParentName(t *testing.T) {
+	for name, want := range map[string]string{
+		"usb2":      "",
+		"2-3":       "usb2",
+		"2-3.1":     "2-3",
+		"2-3.1.4":   "2-3.1",
+		"10-11.2.1": "10-11.2",
+	} {
+		if got := usbParentName(name); got != want {
+			t.Errorf("usbParentName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	vfioClassPath = "class/vfio"
+	vfioPciDriver = "vfio-pci"
+)
+
+// VfioGroup contains the VFIO state of a single IOMMU group.
+// https://www.kernel.org/doc/Documentation/driver-api/vfio.rst
+type VfioGroup struct {
+	ID int
+	// Drivers maps the name of every device in the group to the driver it
+	// is bound to, or an empty string for unbound devices.
+	// /sys/kernel/iommu_groups/<ID>/devices/<Device>/driver
+	Drivers map[string]string
+	// HasGroupNode is true when VFIO has created the group character device
+	// /dev/vfio/<ID>, as listed in /sys/class/vfio/<ID> .
+	HasGroupNode bool
+}
+
+// VfioGroups is a collection of the VFIO state of every IOMMU group in
+// /sys/kernel/iommu_groups .
+//
+// The map keys are the IOMMU group numbers.
+type VfioGroups map[int]VfioGroup
+
+// VfioGroups returns the VFIO state of all IOMMU groups read from
+// /sys/kernel/iommu_groups and /sys/class/vfio .
+func (fs FS) VfioGroups() (VfioGroups, error) {
+	iommuGroups, err := fs.IommuGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(VfioGroups, len(iommuGroups))
+	for id, iommuGroup := range iommuGroups {
+		group := VfioGroup{
+			ID:      id,
+			Drivers: make(map[string]string, len(iommuGroup.Devices)),
+		}
+
+		for _, device := range iommuGroup.Devices {
+			path := fs.sys.Path(iommuGroupsPath, strconv.Itoa(id), "devices", device, "driver")
+			target, err := os.Readlink(path)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					return nil, fmt.Errorf("failed to readlink %q: %w", path, err)
+				}
+				group.Drivers[device] = ""
+				continue
+			}
+			group.Drivers[device] = filepath.Base(target)
+		}
+
+		path := fs.sys.Path(vfioClassPath, strconv.Itoa(id))
+		if _, err := os.Stat(path); err == nil {
+			group.HasGroupNode = true
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to stat %q: %w", path, err)
+		}
+
+		groups[id] = group
+	}
+
+	return groups, nil
+}
+
+// Claimed returns true if every device in the group is bound to vfio-pci
+// and the group is usable through /dev/vfio/<ID>.
+func (g VfioGroup) Claimed() bool {
+	if !g.HasGroupNode || len(g.Drivers) == 0 {
+		return false
+	}
+	for _, driver := range g.Drivers {
+		if driver != vfioPciDriver {
+			return false
+		}
+	}
+	return true
+}
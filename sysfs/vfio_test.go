@@ -0,0 +1,71 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVfioGroups(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.VfioGroups()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := VfioGroups{
+		2: {
+			ID:      2,
+			Drivers: map[string]string{"0000:00:02.1": "pcieport"},
+		},
+		11: {
+			ID:      11,
+			Drivers: map[string]string{"0000:01:00.0": "nvme"},
+		},
+		16: {
+			ID: 16,
+			Drivers: map[string]string{
+				"0000:00:06.0": "vfio-pci",
+				"0000:00:06.1": "vfio-pci",
+			},
+			HasGroupNode: true,
+		},
+		17: {
+			ID: 17,
+			Drivers: map[string]string{
+				"0000:00:07.0": "vfio-pci",
+				"0000:00:07.1": "snd_hda_intel",
+			},
+			HasGroupNode: true,
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected VFIO groups (-want +got):\n%s", diff)
+	}
+
+	for id, claimed := range map[int]bool{2: false, 11: false, 16: true, 17: false} {
+		if got[id].Claimed() != claimed {
+			t.Errorf("group %d: want Claimed() %v, got %v", id, claimed, got[id].Claimed())
+		}
+	}
+}
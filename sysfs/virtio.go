@@ -0,0 +1,127 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+const virtioDevicesPath = "bus/virtio/devices"
+
+// VirtioDevice contains info from files in /sys/bus/virtio/devices for a
+// single virtio device.
+// https://docs.oasis-open.org/virtio/virtio/v1.2/virtio-v1.2.html
+type VirtioDevice struct {
+	Name     string
+	DeviceID *uint32 // /sys/bus/virtio/devices/<Name>/device, e.g. 1 for network devices
+	VendorID *uint32 // /sys/bus/virtio/devices/<Name>/vendor
+	Status   *uint32 // /sys/bus/virtio/devices/<Name>/status
+	// Features holds the numbers of the negotiated feature bits.
+	// /sys/bus/virtio/devices/<Name>/features
+	Features    []uint
+	PciLocation *PciDeviceLocation // PCI device the transport is attached to, nil for virtio-mmio
+}
+
+// VirtioDevices is a collection of every virtio device in
+// /sys/bus/virtio/devices .
+//
+// The map keys are the device names, like "virtio0".
+type VirtioDevices map[string]VirtioDevice
+
+// VirtioDevices returns info for all virtio devices read from
+// /sys/bus/virtio/devices .
+func (fs FS) VirtioDevices() (VirtioDevices, error) {
+	path := fs.sys.Path(virtioDevicesPath)
+
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(VirtioDevices, len(dirs))
+	for _, d := range dirs {
+		device, err := fs.parseVirtioDevice(d.Name())
+		if err != nil {
+			return nil, err
+		}
+		devices[device.Name] = *device
+	}
+
+	return devices, nil
+}
+
+func (fs FS) parseVirtioDevice(name string) (*VirtioDevice, error) {
+	path := fs.sys.Path(virtioDevicesPath, name)
+	device := &VirtioDevice{Name: name}
+
+	for _, f := range [...]string{"device", "vendor", "status", "features"} {
+		file := filepath.Join(path, f)
+		value, err := util.SysReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read file %q: %w", file, err)
+		}
+
+		if f == "features" {
+			// The features file is a string of '0' and '1' characters, one
+			// per feature bit starting at bit 0.
+			for i, c := range value {
+				switch c {
+				case '1':
+					device.Features = append(device.Features, uint(i))
+				case '0':
+				default:
+					return nil, fmt.Errorf("failed to parse %q: invalid feature bit %q", file, c)
+				}
+			}
+			continue
+		}
+
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", file, err)
+		}
+		id := uint32(v)
+
+		switch f {
+		case "device":
+			device.DeviceID = &id
+		case "vendor":
+			device.VendorID = &id
+		case "status":
+			device.Status = &id
+		}
+	}
+
+	// Devices on the virtio-pci transport are children of the PCI device,
+	// like "../../../devices/pci0000:00/0000:00:03.0/virtio0".
+	target, err := os.Readlink(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to readlink %q: %w", path, err)
+	}
+	if location, err := ParsePciDeviceLocation(filepath.Base(filepath.Dir(target))); err == nil {
+		device.PciLocation = location
+	}
+
+	return device, nil
+}
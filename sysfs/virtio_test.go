@@ -0,0 +1,61 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sysfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVirtioDevices(t *testing.T) {
+	fs, err := NewFS(sysTestFixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fs.VirtioDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := VirtioDevices{
+		"virtio0": {
+			Name:     "virtio0",
+			DeviceID: makeUint32(1),
+			VendorID: makeUint32(0x1af4),
+			Status:   makeUint32(0xf),
+			Features: []uint{0, 1, 2, 7, 8, 9, 11},
+			PciLocation: &PciDeviceLocation{
+				Segment:  0,
+				Bus:      0,
+				Device:   5,
+				Function: 0,
+			},
+		},
+		"virtio1": {
+			Name:     "virtio1",
+			DeviceID: makeUint32(2),
+			VendorID: makeUint32(0x554d4551),
+			Status:   makeUint32(0x7),
+			Features: []uint{31},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected VirtioDevices (-want +got):\n%s", diff)
+	}
+}
@@ -28,6 +28,7 @@ const (
 	unknown     = "unknown"
 )
 
+// Vulnerability states as reported in Vulnerability.State .
 const (
 	VulnerabilityStateNotAffected = iota
 	VulnerabilityStateVulnerable
@@ -75,9 +76,9 @@ func (fs FS) CPUVulnerabilities() (map[string]*Vulnerability, error) {
 
 // Vulnerability represents a single vulnerability extracted from /sys/devices/system/cpu/vulnerabilities/.
 type Vulnerability struct {
-	CodeName   string
-	State      int
-	Mitigation string
+	CodeName   string // file name, e.g. "spectre_v2"
+	State      int    // one of the VulnerabilityState* constants
+	Mitigation string // text following the state, e.g. "Retpolines, IBPB: conditional"
 }
 
 func parseVulnerability(name, rawContent string) (*Vulnerability, error) {
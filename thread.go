@@ -36,7 +36,18 @@ func AllThreads(pid int) (Procs, error) {
 
 // AllThreads returns a list of all currently available threads for PID.
 func (fs FS) AllThreads(pid int) (Procs, error) {
-	taskPath := fs.proc.Path(strconv.Itoa(pid), "task")
+	return fs.threads(fs.proc.Path(strconv.Itoa(pid), "task"))
+}
+
+// Tasks returns a list of all currently available threads of Proc. Each
+// thread can be inspected like a process, e.g. with Stat, NewStatus or
+// Schedstat, to find hot or blocked threads.
+func (proc Proc) Tasks() (Procs, error) {
+	return proc.fs.threads(proc.path("task"))
+}
+
+// threads lists the threads in the given /proc/PID/task directory.
+func (fs FS) threads(taskPath string) (Procs, error) {
 	d, err := os.Open(taskPath)
 	if err != nil {
 		return Procs{}, err
@@ -46,6 +46,35 @@ func TestAllThreads(t *testing.T) {
 	}
 }
 
+func TestProcTasks(t *testing.T) {
+	proc, err := getProcFixtures(t).Proc(testPID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks, err := proc.Tasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Sort(tasks)
+	if diff := cmp.Diff(len(testTIDS), len(tasks)); diff != "" {
+		t.Fatalf("unexpected number of tasks (-want +got):\n%s", diff)
+	}
+	for i, tid := range testTIDS {
+		if diff := cmp.Diff(tid, tasks[i].PID); diff != "" {
+			t.Fatalf("unexpected diff (-want +got):\n%s", diff)
+		}
+	}
+
+	schedstat, err := tasks[1].Schedstat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ProcSchedstat{RunningNanoseconds: 283713420, WaitingNanoseconds: 1502140, RunTimeslices: 1204}
+	if diff := cmp.Diff(want, schedstat); diff != "" {
+		t.Fatalf("unexpected task schedstat (-want +got):\n%s", diff)
+	}
+}
+
 func TestThreadStat(t *testing.T) {
 	// Pull process and thread stats.
 	proc, err := getProcFixtures(t).Proc(testPID)
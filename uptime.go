@@ -0,0 +1,65 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/procfs/internal/util"
+)
+
+// Uptime represents the contents of /proc/uptime.
+type Uptime struct {
+	// Uptime is the time since the system booted, including time spent
+	// suspended.
+	Uptime time.Duration
+	// Idle is the time spent idle, summed over all CPUs. It can therefore
+	// exceed Uptime on multi-core systems.
+	Idle time.Duration
+}
+
+// Uptime returns the system uptime and idle time read from /proc/uptime .
+func (fs FS) Uptime() (*Uptime, error) {
+	data, err := util.ReadFileNoStat(fs.proc.Path("uptime"))
+	if err != nil {
+		return nil, err
+	}
+	return parseUptime(data)
+}
+
+// parseUptime parses a line like "350735.47 234388.90" of seconds with
+// centisecond precision.
+func parseUptime(data []byte) (*Uptime, error) {
+	parts := strings.Fields(string(data))
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: Malformed line %q", ErrFileParse, string(data))
+	}
+
+	var values [2]time.Duration
+	for i, part := range parts {
+		// Parsing the decimal seconds as a duration avoids float rounding.
+		d, err := time.ParseDuration(part + "s")
+		if err != nil {
+			return nil, fmt.Errorf("%w: Cannot parse uptime %q: %w", ErrFileParse, part, err)
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("%w: Negative uptime %q", ErrFileParse, part)
+		}
+		values[i] = d
+	}
+
+	return &Uptime{Uptime: values[0], Idle: values[1]}, nil
+}
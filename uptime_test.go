@@ -0,0 +1,44 @@
+// Copyright The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUptime(t *testing.T) {
+	uptime, err := getProcFixtures(t).Uptime()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Uptime{
+		Uptime: 350735*time.Second + 470*time.Millisecond,
+		Idle:   1334388*time.Second + 900*time.Millisecond,
+	}
+	if diff := cmp.Diff(want, uptime); diff != "" {
+		t.Fatalf("unexpected uptime (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseUptime(t *testing.T) {
+	for _, s := range []string{"", "1.00", "1.00 x", "-1.00 2.00", "1.00 2.00 3.00"} {
+		if _, err := parseUptime([]byte(s)); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/prometheus/procfs/internal/fs"
+	"github.com/prometheus/procfs/internal/util"
 )
 
 // Stats contains XFS filesystem runtime statistics, parsed from
@@ -292,6 +293,29 @@ type BtreeInode2Stats struct {
 	Moves     uint32
 }
 
+// ErrorConfig contains the metadata error handling configuration of a
+// single XFS filesystem, read from /sys/fs/xfs/<dev>/error. Only available
+// on kernel 4.7+.
+type ErrorConfig struct {
+	// The name of the filesystem, e.g. "sda1".
+	Name string
+	// FailAtUnmount indicates whether pending retries are given up when the
+	// filesystem is unmounted.
+	FailAtUnmount bool
+	// Metadata contains the retry configuration for failed metadata writes,
+	// keyed by error class ("default", "EIO", "ENOSPC", "ENODEV").
+	Metadata map[string]ErrorRetryConfig
+}
+
+// ErrorRetryConfig contains the retry configuration of one error class in
+// /sys/fs/xfs/<dev>/error/metadata/<class>.
+type ErrorRetryConfig struct {
+	// MaxRetries is the number of retries before giving up, -1 retries forever.
+	MaxRetries int64
+	// RetryTimeoutSeconds is how long to keep retrying, -1 retries forever.
+	RetryTimeoutSeconds int64
+}
+
 // FS represents the pseudo-filesystems proc and sys, which provides an interface to
 // kernel data structures.
 type FS struct {
@@ -370,3 +394,55 @@ func (fs FS) SysStats() ([]*Stats, error) {
 
 	return stats, nil
 }
+
+// SysErrorConfigs retrieves the error handling configuration for each
+// mounted XFS filesystem. On kernels without error configuration, an empty
+// slice of *xfs.ErrorConfig will be returned.
+func (fs FS) SysErrorConfigs() ([]*ErrorConfig, error) {
+	matches, err := filepath.Glob(fs.sys.Path("fs/xfs/*/error"))
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]*ErrorConfig, 0, len(matches))
+	for _, m := range matches {
+		c := &ErrorConfig{
+			// "*" used in glob above indicates the name of the filesystem.
+			Name:     filepath.Base(filepath.Dir(m)),
+			Metadata: make(map[string]ErrorRetryConfig),
+		}
+
+		failAtUnmount, err := util.ReadUintFromFile(filepath.Join(m, "fail_at_unmount"))
+		if err != nil {
+			return nil, err
+		}
+		c.FailAtUnmount = failAtUnmount != 0
+
+		classes, err := os.ReadDir(filepath.Join(m, "metadata"))
+		if err != nil {
+			return nil, err
+		}
+		for _, class := range classes {
+			if !class.IsDir() {
+				continue
+			}
+
+			var rc ErrorRetryConfig
+			for file, p := range map[string]*int64{
+				"max_retries":           &rc.MaxRetries,
+				"retry_timeout_seconds": &rc.RetryTimeoutSeconds,
+			} {
+				v, err := util.ReadIntFromFile(filepath.Join(m, "metadata", class.Name(), file))
+				if err != nil {
+					return nil, err
+				}
+				*p = v
+			}
+			c.Metadata[class.Name()] = rc
+		}
+
+		configs = append(configs, c)
+	}
+
+	return configs, nil
+}
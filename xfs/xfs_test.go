@@ -17,6 +17,8 @@ package xfs_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/prometheus/procfs/xfs"
 )
 
@@ -80,3 +82,31 @@ func TestReadSysStats(t *testing.T) {
 		}
 	}
 }
+
+func TestReadSysErrorConfigs(t *testing.T) {
+	fs, err := xfs.NewFS("testdata/fixtures/proc", "testdata/fixtures/sys")
+	if err != nil {
+		t.Fatalf("failed to access xfs fs: %v", err)
+	}
+	got, err := fs.SysErrorConfigs()
+	if err != nil {
+		t.Fatalf("failed to parse XFS error configs: %v", err)
+	}
+
+	want := []*xfs.ErrorConfig{
+		{
+			Name:          "sda1",
+			FailAtUnmount: true,
+			Metadata: map[string]xfs.ErrorRetryConfig{
+				"default": {MaxRetries: -1, RetryTimeoutSeconds: -1},
+				"EIO":     {MaxRetries: -1, RetryTimeoutSeconds: 0},
+				"ENOSPC":  {MaxRetries: -1, RetryTimeoutSeconds: 30},
+				"ENODEV":  {MaxRetries: 0, RetryTimeoutSeconds: 0},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected XFS error configs (-want +got):\n%s", diff)
+	}
+}
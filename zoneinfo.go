@@ -64,6 +64,22 @@ type Zoneinfo struct {
 	NumaLocal                  *int64
 	NumaOther                  *int64
 	Protection                 []*int64
+	// Pagesets holds the per-CPU page lists of the zone.
+	Pagesets []ZoneinfoPageset
+}
+
+// ZoneinfoPageset contains the per-CPU page list of a zone, from the
+// "pagesets" section of /proc/zoneinfo.
+type ZoneinfoPageset struct {
+	CPU string
+	// Number of pages in the list.
+	Count *int64
+	// Number of pages above which the list is drained back to the zone.
+	High *int64
+	// Number of pages moved between the list and the zone at once.
+	Batch *int64
+	// Threshold of the per-CPU VM statistics deltas.
+	VMStatsThreshold *int64
 }
 
 var nodeZoneRE = regexp.MustCompile(`(\d+), zone\s+(\w+)`)
@@ -104,7 +120,28 @@ func parseZoneinfo(zoneinfoData []byte) ([]Zoneinfo, error) {
 				continue
 			}
 			vp := util.NewValueParser(parts[1])
+			if pagesets := zoneinfoElement.Pagesets; len(pagesets) > 0 {
+				pageset := &pagesets[len(pagesets)-1]
+				switch parts[0] {
+				case "count:":
+					pageset.Count = vp.PInt64()
+					continue
+				case "high:":
+					pageset.High = vp.PInt64()
+					continue
+				case "batch:":
+					pageset.Batch = vp.PInt64()
+					continue
+				case "vm":
+					if len(parts) == 4 && parts[1] == "stats" && parts[2] == "threshold:" {
+						pageset.VMStatsThreshold = util.NewValueParser(parts[3]).PInt64()
+					}
+					continue
+				}
+			}
 			switch parts[0] {
+			case "cpu:":
+				zoneinfoElement.Pagesets = append(zoneinfoElement.Pagesets, ZoneinfoPageset{CPU: parts[1]})
 			case "nr_free_pages":
 				zoneinfoElement.NrFreePages = vp.PInt64()
 			case "min":
@@ -30,10 +30,41 @@ func TestZoneinfo(t *testing.T) {
 	protectionMovable := []*int64{newPInt64(0), newPInt64(0), newPInt64(0), newPInt64(0), newPInt64(0)}
 	protectionDevice := []*int64{newPInt64(0), newPInt64(0), newPInt64(0), newPInt64(0), newPInt64(0)}
 
+	pagesetsDMA := []ZoneinfoPageset{
+		{CPU: "0", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "1", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "2", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "3", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "4", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "5", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "6", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+		{CPU: "7", Count: newPInt64(0), High: newPInt64(0), Batch: newPInt64(1), VMStatsThreshold: newPInt64(8)},
+	}
+	pagesetsDMA32 := []ZoneinfoPageset{
+		{CPU: "0", Count: newPInt64(345), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "1", Count: newPInt64(356), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "2", Count: newPInt64(325), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "3", Count: newPInt64(346), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "4", Count: newPInt64(321), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "5", Count: newPInt64(316), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "6", Count: newPInt64(373), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+		{CPU: "7", Count: newPInt64(339), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(48)},
+	}
+	pagesetsNormal := []ZoneinfoPageset{
+		{CPU: "0", Count: newPInt64(316), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "1", Count: newPInt64(366), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "2", Count: newPInt64(60), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "3", Count: newPInt64(256), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "4", Count: newPInt64(253), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "5", Count: newPInt64(159), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "6", Count: newPInt64(311), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+		{CPU: "7", Count: newPInt64(264), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)},
+	}
+
 	refs := []Zoneinfo{
-		{Node: "0", Zone: "DMA", NrFreePages: newPInt64(3952), Min: newPInt64(33), Low: newPInt64(41), High: newPInt64(49), Spanned: newPInt64(4095), Present: newPInt64(3975), Managed: newPInt64(3956), NrActiveAnon: newPInt64(547580), NrInactiveAnon: newPInt64(230981), NrIsolatedAnon: newPInt64(0), NrAnonPages: newPInt64(795576), NrAnonTransparentHugepages: newPInt64(0), NrActiveFile: newPInt64(346282), NrInactiveFile: newPInt64(316904), NrIsolatedFile: newPInt64(0), NrFilePages: newPInt64(761874), NrSlabReclaimable: newPInt64(131220), NrSlabUnreclaimable: newPInt64(47320), NrKernelStack: newPInt64(0), NrMapped: newPInt64(215483), NrDirty: newPInt64(908), NrWriteback: newPInt64(0), NrUnevictable: newPInt64(115467), NrShmem: newPInt64(224925), NrDirtied: newPInt64(8007423), NrWritten: newPInt64(7752121), NumaHit: newPInt64(1), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(0), NumaLocal: newPInt64(1), NumaOther: newPInt64(0), Protection: protectionDMA},
-		{Node: "0", Zone: "DMA32", NrFreePages: newPInt64(204252), Min: newPInt64(19510), Low: newPInt64(21059), High: newPInt64(22608), Spanned: newPInt64(1044480), Present: newPInt64(759231), Managed: newPInt64(742806), NrKernelStack: newPInt64(2208), NumaHit: newPInt64(113952967), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(0), NumaLocal: newPInt64(113952967), NumaOther: newPInt64(0), Protection: protectionDMA32},
-		{Node: "0", Zone: "Normal", NrFreePages: newPInt64(18553), Min: newPInt64(11176), Low: newPInt64(13842), High: newPInt64(16508), Spanned: newPInt64(1308160), Present: newPInt64(1308160), Managed: newPInt64(1268711), NrKernelStack: newPInt64(15136), NumaHit: newPInt64(162718019), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(26812), NumaLocal: newPInt64(162718019), NumaOther: newPInt64(0), Protection: protectionNormal},
+		{Node: "0", Zone: "DMA", NrFreePages: newPInt64(3952), Min: newPInt64(33), Low: newPInt64(41), High: newPInt64(49), Spanned: newPInt64(4095), Present: newPInt64(3975), Managed: newPInt64(3956), NrActiveAnon: newPInt64(547580), NrInactiveAnon: newPInt64(230981), NrIsolatedAnon: newPInt64(0), NrAnonPages: newPInt64(795576), NrAnonTransparentHugepages: newPInt64(0), NrActiveFile: newPInt64(346282), NrInactiveFile: newPInt64(316904), NrIsolatedFile: newPInt64(0), NrFilePages: newPInt64(761874), NrSlabReclaimable: newPInt64(131220), NrSlabUnreclaimable: newPInt64(47320), NrKernelStack: newPInt64(0), NrMapped: newPInt64(215483), NrDirty: newPInt64(908), NrWriteback: newPInt64(0), NrUnevictable: newPInt64(115467), NrShmem: newPInt64(224925), NrDirtied: newPInt64(8007423), NrWritten: newPInt64(7752121), NumaHit: newPInt64(1), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(0), NumaLocal: newPInt64(1), NumaOther: newPInt64(0), Protection: protectionDMA, Pagesets: pagesetsDMA},
+		{Node: "0", Zone: "DMA32", NrFreePages: newPInt64(204252), Min: newPInt64(19510), Low: newPInt64(21059), High: newPInt64(22608), Spanned: newPInt64(1044480), Present: newPInt64(759231), Managed: newPInt64(742806), NrKernelStack: newPInt64(2208), NumaHit: newPInt64(113952967), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(0), NumaLocal: newPInt64(113952967), NumaOther: newPInt64(0), Protection: protectionDMA32, Pagesets: pagesetsDMA32},
+		{Node: "0", Zone: "Normal", NrFreePages: newPInt64(18553), Min: newPInt64(11176), Low: newPInt64(13842), High: newPInt64(16508), Spanned: newPInt64(1308160), Present: newPInt64(1308160), Managed: newPInt64(1268711), NrKernelStack: newPInt64(15136), NumaHit: newPInt64(162718019), NumaMiss: newPInt64(0), NumaForeign: newPInt64(0), NumaInterleave: newPInt64(26812), NumaLocal: newPInt64(162718019), NumaOther: newPInt64(0), Protection: protectionNormal, Pagesets: pagesetsNormal},
 		{Node: "0", Zone: "Movable", Min: newPInt64(0), Low: newPInt64(0), High: newPInt64(0), Spanned: newPInt64(0), Present: newPInt64(0), Managed: newPInt64(0), Protection: protectionMovable},
 		{Node: "0", Zone: "Device", Min: newPInt64(0), Low: newPInt64(0), High: newPInt64(0), Spanned: newPInt64(0), Present: newPInt64(0), Managed: newPInt64(0), Protection: protectionDevice},
 	}
@@ -54,3 +85,24 @@ func TestZoneinfo(t *testing.T) {
 
 	}
 }
+
+func TestZoneinfoPagesets(t *testing.T) {
+	data, err := getProcFixtures(t).Zoneinfo()
+	if err != nil {
+		t.Fatalf("failed to parse zoneinfo: %v", err)
+	}
+
+	normal := data[2]
+	if want, have := 8, len(normal.Pagesets); want != have {
+		t.Fatalf("want %d pagesets, have %d", want, have)
+	}
+	want := ZoneinfoPageset{CPU: "1", Count: newPInt64(366), High: newPInt64(378), Batch: newPInt64(63), VMStatsThreshold: newPInt64(56)}
+	if diff := cmp.Diff(want, normal.Pagesets[1]); diff != "" {
+		t.Fatalf("unexpected pageset (-want +got):\n%s", diff)
+	}
+
+	// The pageset high mark must not clobber the zone watermark.
+	if want, have := int64(16508), *normal.High; want != have {
+		t.Errorf("want zone high watermark %d, have %d", want, have)
+	}
+}